@@ -2,34 +2,81 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
+	"strings"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1/treatmentv1connect"
 	"github.com/tierklinik-dobersberg/apis/pkg/discovery/wellknown"
 	base "github.com/tierklinik-dobersberg/apis/pkg/service"
+	"github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha/treatmentv1alphaconnect"
 	"github.com/tierklinik-dobersberg/treatment-service/internal/config"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/gateway"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/health"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/metrics"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/ratelimit"
 	"github.com/tierklinik-dobersberg/treatment-service/internal/service"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/tracing"
 )
 
-var serverContextKey = struct{ S string }{S: "serverContextKey"}
+// Exit codes, see sysexits.h.
+const (
+	exitFailure     = 1
+	exitUnavailable = 69
+	exitConfig      = 78
+)
 
 func main() {
+	os.Exit(run())
+}
+
+// run starts the service and returns the exit code. Deferred cleanups, like
+// flushing traces, run before the process exits.
+func run() int {
 	ctx := context.Background()
 
+	// add trace and span IDs to all log records
+	slog.SetDefault(slog.New(tracing.NewLogHandler(slog.NewTextHandler(os.Stderr, nil))))
+
 	instance, err := base.Configure(
 		wellknown.TreatmentV1ServiceScope,
 		config.Config{},
 	)
 	if err != nil {
-		slog.Error("failed to configure service instance: %w", err)
+		slog.Error("failed to configure service instance", "error", err)
+		return exitConfig
+	}
+
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+		ServiceName:  string(wellknown.TreatmentV1ServiceScope),
+		Exporter:     instance.Config.TracingExporter,
+		OTLPEndpoint: instance.Config.TracingOTLPEndpoint,
+		OTLPInsecure: instance.Config.TracingOTLPInsecure,
+		SampleRatio:  instance.Config.TracingSampleRatio,
+	})
+	if err != nil {
+		slog.Error("failed to setup tracing", "error", err)
+		return exitConfig
 	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+	}()
 
 	providers, err := config.NewProviders(ctx, instance)
 	if err != nil {
 		slog.Error("failed to prepare providers", "error", err)
-		os.Exit(1)
+
+		if errors.Is(err, config.ErrInvalidConfig) {
+			return exitConfig
+		}
+
+		return exitUnavailable
 	}
 
 	slog.Info("application providers prepared successfully")
@@ -37,16 +84,140 @@ func main() {
 	// create a new CallService and add it to the mux.
 	svc := service.New(providers)
 
-	path, handler := treatmentv1connect.NewSpeciesServiceHandler(svc, connect.WithOptions(instance.ConnectOptions()...))
+	if err := metrics.RegisterCatalog(providers.Repository.CountCatalog); err != nil {
+		slog.Error("failed to register catalog metrics", "error", err)
+		return exitFailure
+	}
+
+	// trace and record metrics for all RPCs, including the ones rejected
+	// by the default interceptors.
+	handlerOptions := connect.WithOptions(append(
+		[]connect.Option{connect.WithInterceptors(tracing.NewInterceptor(), metrics.NewInterceptor())},
+		instance.ConnectOptions()...,
+	)...)
+
+	path, handler := treatmentv1connect.NewSpeciesServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	path, handler = treatmentv1connect.NewTreatmentServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	path, handler = treatmentv1alphaconnect.NewDetectionServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	path, handler = treatmentv1alphaconnect.NewTreatmentDetailsServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	path, handler = treatmentv1alphaconnect.NewBundleServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	path, handler = treatmentv1alphaconnect.NewPricingServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	path, handler = treatmentv1alphaconnect.NewBreedServiceHandler(svc, handlerOptions)
 	instance.Mux.Shared.Handle(path, handler)
 
-	path, handler = treatmentv1connect.NewTreatmentServiceHandler(svc, connect.WithOptions(instance.ConnectOptions()...))
+	path, handler = treatmentv1alphaconnect.NewSpeciesHierarchyServiceHandler(svc, handlerOptions)
 	instance.Mux.Shared.Handle(path, handler)
 
+	path, handler = treatmentv1alphaconnect.NewSpeciesMaintenanceServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	path, handler = treatmentv1alphaconnect.NewTreatmentMaintenanceServiceHandler(svc, handlerOptions)
+	instance.Mux.Shared.Handle(path, handler)
+
+	// the self-booking catalog is public and does not require authentication
+	// so make sure clients cannot overload the service.
+	limiter := ratelimit.New(instance.Config.CatalogRateLimit, instance.Config.CatalogRateLimitBurst)
+	path, handler = treatmentv1alphaconnect.NewSelfBookingCatalogServiceHandler(
+		svc,
+		connect.WithInterceptors(tracing.NewInterceptor(), metrics.NewInterceptor(), ratelimit.NewInterceptor(limiter)),
+		connect.WithOptions(instance.ConnectOptions()...),
+	)
+	instance.Mux.Public.Handle(path, handler)
+
+	// expose species and treatments as REST resources. The gateway calls
+	// the connect handlers in-process so all interceptors apply.
+	inProcess := gateway.NewInProcessClient(instance.Mux.Shared)
+	gw := gateway.New(
+		treatmentv1connect.NewSpeciesServiceClient(inProcess, gateway.InProcessBaseURL),
+		treatmentv1connect.NewTreatmentServiceClient(inProcess, gateway.InProcessBaseURL),
+		treatmentv1alphaconnect.NewTreatmentDetailsServiceClient(inProcess, gateway.InProcessBaseURL),
+	)
+	instance.Mux.Shared.Handle("/v1/", gw.Handler())
+
+	// liveness and readiness checks for orchestrators and gRPC clients
+	checker := health.New()
+	checker.Add("database", providers.Repository.Ping)
+	checker.Add("indexes", func(ctx context.Context) error {
+		missing, err := providers.Repository.MissingIndexes(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(missing) > 0 {
+			return fmt.Errorf("missing indexes: %s", strings.Join(missing, ", "))
+		}
+
+		return nil
+	})
+	checker.Add("migrations", func(ctx context.Context) error {
+		pending, err := providers.Repository.PendingMigrations(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(pending) > 0 {
+			return fmt.Errorf("pending migrations: %s", strings.Join(pending, ", "))
+		}
+
+		return nil
+	})
+
+	instance.Mux.Shared.Handle("/healthz", checker.LivenessHandler())
+	instance.Mux.Shared.Handle("/readyz", checker.ReadinessHandler())
+
+	path, handler = health.NewGRPCHandler(checker, []string{
+		treatmentv1connect.SpeciesServiceName,
+		treatmentv1connect.TreatmentServiceName,
+		treatmentv1alphaconnect.DetectionServiceName,
+		treatmentv1alphaconnect.TreatmentDetailsServiceName,
+		treatmentv1alphaconnect.BundleServiceName,
+		treatmentv1alphaconnect.PricingServiceName,
+		treatmentv1alphaconnect.BreedServiceName,
+		treatmentv1alphaconnect.SpeciesHierarchyServiceName,
+		treatmentv1alphaconnect.SpeciesMaintenanceServiceName,
+		treatmentv1alphaconnect.TreatmentMaintenanceServiceName,
+		treatmentv1alphaconnect.SelfBookingCatalogServiceName,
+	})
+	instance.Mux.Shared.Handle(path, handler)
+
+	// the service stops if any of the servers fails
+	errs := make(chan error, 2)
+
+	if addr := instance.Config.MetricsListen; addr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle(instance.Config.MetricsPath, metrics.Handler())
+
+		go func() {
+			slog.Info("serving metrics", "address", addr, "path", instance.Config.MetricsPath)
+
+			if err := http.ListenAndServe(addr, metricsMux); err != nil {
+				errs <- fmt.Errorf("failed to serve metrics: %w", err)
+			}
+		}()
+	}
+
 	slog.Info("HTTP/2 server (h2c) prepared successfully, starting to listen ...")
 
-	if err := instance.Run(); err != nil {
+	go func() {
+		errs <- instance.Run()
+	}()
+
+	if err := <-errs; err != nil {
 		slog.Error("failed to serve", "error", err)
-		os.Exit(1)
+		return exitFailure
 	}
+
+	return 0
 }
@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/breed.proto
+
+package treatmentv1alpha
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Breed is a breed of a species.
+type Breed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the unique name of the breed.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Species is the name of the species the breed belongs to.
+	Species string `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	// DisplayName is a human readable name of the breed.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// MatchWords is used for breed detection, see DetectBreeds.
+	MatchWords []string `protobuf:"bytes,4,rep,name=match_words,json=matchWords,proto3" json:"match_words,omitempty"`
+	Icon       *v1.Icon `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Attributes holds additional, free-form attributes of the breed
+	// like "brachycephalic" or "giant-breed".
+	Attributes    []string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Breed) Reset() {
+	*x = Breed{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Breed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Breed) ProtoMessage() {}
+
+func (x *Breed) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Breed.ProtoReflect.Descriptor instead.
+func (*Breed) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Breed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Breed) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Breed) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Breed) GetMatchWords() []string {
+	if x != nil {
+		return x.MatchWords
+	}
+	return nil
+}
+
+func (x *Breed) GetIcon() *v1.Icon {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *Breed) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GetBreedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBreedRequest) Reset() {
+	*x = GetBreedRequest{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBreedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBreedRequest) ProtoMessage() {}
+
+func (x *GetBreedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBreedRequest.ProtoReflect.Descriptor instead.
+func (*GetBreedRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBreedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListBreedsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Species might be set to only return breeds of the given species.
+	Species string `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	// Attributes might be set to only return breeds that have all of
+	// the given attributes.
+	Attributes    []string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBreedsRequest) Reset() {
+	*x = ListBreedsRequest{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBreedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBreedsRequest) ProtoMessage() {}
+
+func (x *ListBreedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBreedsRequest.ProtoReflect.Descriptor instead.
+func (*ListBreedsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListBreedsRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *ListBreedsRequest) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ListBreedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Breeds        []*Breed               `protobuf:"bytes,1,rep,name=breeds,proto3" json:"breeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBreedsResponse) Reset() {
+	*x = ListBreedsResponse{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBreedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBreedsResponse) ProtoMessage() {}
+
+func (x *ListBreedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBreedsResponse.ProtoReflect.Descriptor instead.
+func (*ListBreedsResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListBreedsResponse) GetBreeds() []*Breed {
+	if x != nil {
+		return x.Breeds
+	}
+	return nil
+}
+
+type UpdateBreedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Breed *Breed                 `protobuf:"bytes,2,opt,name=breed,proto3" json:"breed,omitempty"`
+	// UpdateMask specifies which fields of breed should be updated. If
+	// empty, all fields except name are updated.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBreedRequest) Reset() {
+	*x = UpdateBreedRequest{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBreedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBreedRequest) ProtoMessage() {}
+
+func (x *UpdateBreedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBreedRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBreedRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateBreedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBreedRequest) GetBreed() *Breed {
+	if x != nil {
+		return x.Breed
+	}
+	return nil
+}
+
+func (x *UpdateBreedRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteBreedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBreedRequest) Reset() {
+	*x = DeleteBreedRequest{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBreedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBreedRequest) ProtoMessage() {}
+
+func (x *DeleteBreedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBreedRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBreedRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteBreedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DetectBreedsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Values holds the values to detect breeds in.
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	// Species might be set to only detect breeds of the given species.
+	Species       string `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectBreedsRequest) Reset() {
+	*x = DetectBreedsRequest{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectBreedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectBreedsRequest) ProtoMessage() {}
+
+func (x *DetectBreedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectBreedsRequest.ProtoReflect.Descriptor instead.
+func (*DetectBreedsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DetectBreedsRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DetectBreedsRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+type DetectBreedsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Breeds holds the detected breeds, the best match first.
+	Breeds []*Breed `protobuf:"bytes,1,rep,name=breeds,proto3" json:"breeds,omitempty"`
+	// Species holds the distinct species of the detected breeds in the
+	// order of breeds.
+	Species       []string `protobuf:"bytes,2,rep,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectBreedsResponse) Reset() {
+	*x = DetectBreedsResponse{}
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectBreedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectBreedsResponse) ProtoMessage() {}
+
+func (x *DetectBreedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_breed_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectBreedsResponse.ProtoReflect.Descriptor instead.
+func (*DetectBreedsResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DetectBreedsResponse) GetBreeds() []*Breed {
+	if x != nil {
+		return x.Breeds
+	}
+	return nil
+}
+
+func (x *DetectBreedsResponse) GetSpecies() []string {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+var File_tkd_treatment_v1alpha_breed_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_breed_proto_rawDesc = "" +
+	"\n" +
+	"!tkd/treatment/v1alpha/breed.proto\x12\x15tkd.treatment.v1alpha\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1etkd/common/v1/descriptor.proto\x1a\x1etkd/treatment/v1/species.proto\"\xd5\x01\n" +
+	"\x05Breed\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12 \n" +
+	"\aspecies\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aspecies\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1f\n" +
+	"\vmatch_words\x18\x04 \x03(\tR\n" +
+	"matchWords\x12*\n" +
+	"\x04icon\x18\x05 \x01(\v2\x16.tkd.treatment.v1.IconR\x04icon\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\tR\n" +
+	"attributes\"-\n" +
+	"\x0fGetBreedRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"M\n" +
+	"\x11ListBreedsRequest\x12\x18\n" +
+	"\aspecies\x18\x01 \x01(\tR\aspecies\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\tR\n" +
+	"attributes\"J\n" +
+	"\x12ListBreedsResponse\x124\n" +
+	"\x06breeds\x18\x01 \x03(\v2\x1c.tkd.treatment.v1alpha.BreedR\x06breeds\"\xa9\x01\n" +
+	"\x12UpdateBreedRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12:\n" +
+	"\x05breed\x18\x02 \x01(\v2\x1c.tkd.treatment.v1alpha.BreedB\x06\xbaH\x03\xc8\x01\x01R\x05breed\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"0\n" +
+	"\x12DeleteBreedRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"Q\n" +
+	"\x13DetectBreedsRequest\x12 \n" +
+	"\x06values\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\x06values\x12\x18\n" +
+	"\aspecies\x18\x02 \x01(\tR\aspecies\"f\n" +
+	"\x14DetectBreedsResponse\x124\n" +
+	"\x06breeds\x18\x01 \x03(\v2\x1c.tkd.treatment.v1alpha.BreedR\x06breeds\x12\x18\n" +
+	"\aspecies\x18\x02 \x03(\tR\aspecies2\xcb\x04\n" +
+	"\fBreedService\x12P\n" +
+	"\vCreateBreed\x12\x1c.tkd.treatment.v1alpha.Breed\x1a\x1c.tkd.treatment.v1alpha.Breed\"\x05\xb2~\x02\b\x01\x12W\n" +
+	"\bGetBreed\x12&.tkd.treatment.v1alpha.GetBreedRequest\x1a\x1c.tkd.treatment.v1alpha.Breed\"\x05\xb2~\x02\b\x01\x12h\n" +
+	"\n" +
+	"ListBreeds\x12(.tkd.treatment.v1alpha.ListBreedsRequest\x1a).tkd.treatment.v1alpha.ListBreedsResponse\"\x05\xb2~\x02\b\x01\x12]\n" +
+	"\vUpdateBreed\x12).tkd.treatment.v1alpha.UpdateBreedRequest\x1a\x1c.tkd.treatment.v1alpha.Breed\"\x05\xb2~\x02\b\x01\x12W\n" +
+	"\vDeleteBreed\x12).tkd.treatment.v1alpha.DeleteBreedRequest\x1a\x16.google.protobuf.Empty\"\x05\xb2~\x02\b\x01\x12n\n" +
+	"\fDetectBreeds\x12*.tkd.treatment.v1alpha.DetectBreedsRequest\x1a+.tkd.treatment.v1alpha.DetectBreedsResponse\"\x05\xb2~\x02\b\x01BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_breed_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_breed_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_breed_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_breed_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_breed_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_breed_proto_rawDesc), len(file_tkd_treatment_v1alpha_breed_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_breed_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_breed_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_tkd_treatment_v1alpha_breed_proto_goTypes = []any{
+	(*Breed)(nil),                 // 0: tkd.treatment.v1alpha.Breed
+	(*GetBreedRequest)(nil),       // 1: tkd.treatment.v1alpha.GetBreedRequest
+	(*ListBreedsRequest)(nil),     // 2: tkd.treatment.v1alpha.ListBreedsRequest
+	(*ListBreedsResponse)(nil),    // 3: tkd.treatment.v1alpha.ListBreedsResponse
+	(*UpdateBreedRequest)(nil),    // 4: tkd.treatment.v1alpha.UpdateBreedRequest
+	(*DeleteBreedRequest)(nil),    // 5: tkd.treatment.v1alpha.DeleteBreedRequest
+	(*DetectBreedsRequest)(nil),   // 6: tkd.treatment.v1alpha.DetectBreedsRequest
+	(*DetectBreedsResponse)(nil),  // 7: tkd.treatment.v1alpha.DetectBreedsResponse
+	(*v1.Icon)(nil),               // 8: tkd.treatment.v1.Icon
+	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+}
+var file_tkd_treatment_v1alpha_breed_proto_depIdxs = []int32{
+	8,  // 0: tkd.treatment.v1alpha.Breed.icon:type_name -> tkd.treatment.v1.Icon
+	0,  // 1: tkd.treatment.v1alpha.ListBreedsResponse.breeds:type_name -> tkd.treatment.v1alpha.Breed
+	0,  // 2: tkd.treatment.v1alpha.UpdateBreedRequest.breed:type_name -> tkd.treatment.v1alpha.Breed
+	9,  // 3: tkd.treatment.v1alpha.UpdateBreedRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 4: tkd.treatment.v1alpha.DetectBreedsResponse.breeds:type_name -> tkd.treatment.v1alpha.Breed
+	0,  // 5: tkd.treatment.v1alpha.BreedService.CreateBreed:input_type -> tkd.treatment.v1alpha.Breed
+	1,  // 6: tkd.treatment.v1alpha.BreedService.GetBreed:input_type -> tkd.treatment.v1alpha.GetBreedRequest
+	2,  // 7: tkd.treatment.v1alpha.BreedService.ListBreeds:input_type -> tkd.treatment.v1alpha.ListBreedsRequest
+	4,  // 8: tkd.treatment.v1alpha.BreedService.UpdateBreed:input_type -> tkd.treatment.v1alpha.UpdateBreedRequest
+	5,  // 9: tkd.treatment.v1alpha.BreedService.DeleteBreed:input_type -> tkd.treatment.v1alpha.DeleteBreedRequest
+	6,  // 10: tkd.treatment.v1alpha.BreedService.DetectBreeds:input_type -> tkd.treatment.v1alpha.DetectBreedsRequest
+	0,  // 11: tkd.treatment.v1alpha.BreedService.CreateBreed:output_type -> tkd.treatment.v1alpha.Breed
+	0,  // 12: tkd.treatment.v1alpha.BreedService.GetBreed:output_type -> tkd.treatment.v1alpha.Breed
+	3,  // 13: tkd.treatment.v1alpha.BreedService.ListBreeds:output_type -> tkd.treatment.v1alpha.ListBreedsResponse
+	0,  // 14: tkd.treatment.v1alpha.BreedService.UpdateBreed:output_type -> tkd.treatment.v1alpha.Breed
+	10, // 15: tkd.treatment.v1alpha.BreedService.DeleteBreed:output_type -> google.protobuf.Empty
+	7,  // 16: tkd.treatment.v1alpha.BreedService.DetectBreeds:output_type -> tkd.treatment.v1alpha.DetectBreedsResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_breed_proto_init() }
+func file_tkd_treatment_v1alpha_breed_proto_init() {
+	if File_tkd_treatment_v1alpha_breed_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_breed_proto_rawDesc), len(file_tkd_treatment_v1alpha_breed_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_breed_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_breed_proto_depIdxs,
+		MessageInfos:      file_tkd_treatment_v1alpha_breed_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_breed_proto = out.File
+	file_tkd_treatment_v1alpha_breed_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_breed_proto_depIdxs = nil
+}
@@ -0,0 +1,593 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/bundle.proto
+
+package treatmentv1alpha
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BundleConstraints holds the effective constraints of a bundle that are
+// computed from its member treatments.
+type BundleConstraints struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Duration is the duration of the bundle. If the bundle does not specify
+	// a duration, it is calculated the same way as for self-booked appointments
+	// with multiple treatments: the highest initial_time_requirement plus the
+	// additional_time_requirement of each other treatment.
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Species is the list of species all member treatments apply to. If empty,
+	// the bundle applies to all species.
+	Species []string `protobuf:"bytes,2,rep,name=species,proto3" json:"species,omitempty"`
+	// AllowedEmployees is the intersection of the allowed employees of all
+	// member treatments. If empty, any employee is allowed.
+	AllowedEmployees []string `protobuf:"bytes,3,rep,name=allowed_employees,json=allowedEmployees,proto3" json:"allowed_employees,omitempty"`
+	// PreferredEmployees holds all preferred employees of the member treatments
+	// that are allowed to handle the whole bundle.
+	PreferredEmployees []string `protobuf:"bytes,4,rep,name=preferred_employees,json=preferredEmployees,proto3" json:"preferred_employees,omitempty"`
+	// Resources is the union of all resources required by the member
+	// treatments.
+	Resources []string `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	// AllowSelfBooking is true if the bundle and all member treatments may be
+	// self-booked.
+	AllowSelfBooking bool `protobuf:"varint,6,opt,name=allow_self_booking,json=allowSelfBooking,proto3" json:"allow_self_booking,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BundleConstraints) Reset() {
+	*x = BundleConstraints{}
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleConstraints) ProtoMessage() {}
+
+func (x *BundleConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleConstraints.ProtoReflect.Descriptor instead.
+func (*BundleConstraints) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BundleConstraints) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *BundleConstraints) GetSpecies() []string {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *BundleConstraints) GetAllowedEmployees() []string {
+	if x != nil {
+		return x.AllowedEmployees
+	}
+	return nil
+}
+
+func (x *BundleConstraints) GetPreferredEmployees() []string {
+	if x != nil {
+		return x.PreferredEmployees
+	}
+	return nil
+}
+
+func (x *BundleConstraints) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *BundleConstraints) GetAllowSelfBooking() bool {
+	if x != nil {
+		return x.AllowSelfBooking
+	}
+	return false
+}
+
+// Bundle is a bookable package of multiple treatments.
+type Bundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the unique name of the bundle.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// DisplayName is a human readable name of the bundle.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// HelpText holds an additional help-text to be displayed in the
+	// self-booking user interface.
+	HelpText string `protobuf:"bytes,3,opt,name=help_text,json=helpText,proto3" json:"help_text,omitempty"`
+	// Treatments is the list of treatment names that are part of the bundle.
+	// A bundle consists of at least two treatments. If member treatments
+	// are deleted, the duration override is cleared and bundles with less
+	// than two remaining treatments are deleted.
+	Treatments []string `protobuf:"bytes,4,rep,name=treatments,proto3" json:"treatments,omitempty"`
+	// Duration might be set to override the calculated duration of the
+	// bundle, for example, if the treatments can be performed in less time
+	// when booked together.
+	Duration *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// AllowSelfBooking should be set to true if the bundle is available for
+	// self-booking. Note that all member treatments must allow self-booking
+	// as well.
+	AllowSelfBooking bool `protobuf:"varint,6,opt,name=allow_self_booking,json=allowSelfBooking,proto3" json:"allow_self_booking,omitempty"`
+	// Effective holds the computed constraints of the bundle. This field
+	// is ignored when creating or updating bundles and is unset if the
+	// member treatments have been changed so they cannot be booked together
+	// anymore.
+	Effective     *BundleConstraints `protobuf:"bytes,7,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bundle) Reset() {
+	*x = Bundle{}
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bundle) ProtoMessage() {}
+
+func (x *Bundle) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bundle.ProtoReflect.Descriptor instead.
+func (*Bundle) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Bundle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bundle) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Bundle) GetHelpText() string {
+	if x != nil {
+		return x.HelpText
+	}
+	return ""
+}
+
+func (x *Bundle) GetTreatments() []string {
+	if x != nil {
+		return x.Treatments
+	}
+	return nil
+}
+
+func (x *Bundle) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Bundle) GetAllowSelfBooking() bool {
+	if x != nil {
+		return x.AllowSelfBooking
+	}
+	return false
+}
+
+func (x *Bundle) GetEffective() *BundleConstraints {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+type GetBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleRequest) Reset() {
+	*x = GetBundleRequest{}
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleRequest) ProtoMessage() {}
+
+func (x *GetBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListBundlesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Treatment might be set to only return bundles that contain the given
+	// treatment.
+	Treatment     string `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBundlesRequest) Reset() {
+	*x = ListBundlesRequest{}
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBundlesRequest) ProtoMessage() {}
+
+func (x *ListBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBundlesRequest.ProtoReflect.Descriptor instead.
+func (*ListBundlesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListBundlesRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+type ListBundlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundles       []*Bundle              `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBundlesResponse) Reset() {
+	*x = ListBundlesResponse{}
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBundlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBundlesResponse) ProtoMessage() {}
+
+func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBundlesResponse.ProtoReflect.Descriptor instead.
+func (*ListBundlesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBundlesResponse) GetBundles() []*Bundle {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+type UpdateBundleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bundle *Bundle                `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// UpdateMask specifies which fields of bundle should be updated.
+	// If empty, all fields are updated.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBundleRequest) Reset() {
+	*x = UpdateBundleRequest{}
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBundleRequest) ProtoMessage() {}
+
+func (x *UpdateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBundleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBundleRequest) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *UpdateBundleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBundleRequest) Reset() {
+	*x = DeleteBundleRequest{}
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBundleRequest) ProtoMessage() {}
+
+func (x *DeleteBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_bundle_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBundleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBundleRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_tkd_treatment_v1alpha_bundle_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_bundle_proto_rawDesc = "" +
+	"\n" +
+	"\"tkd/treatment/v1alpha/bundle.proto\x12\x15tkd.treatment.v1alpha\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1etkd/common/v1/descriptor.proto\"\x8e\x02\n" +
+	"\x11BundleConstraints\x125\n" +
+	"\bduration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x18\n" +
+	"\aspecies\x18\x02 \x03(\tR\aspecies\x12+\n" +
+	"\x11allowed_employees\x18\x03 \x03(\tR\x10allowedEmployees\x12/\n" +
+	"\x13preferred_employees\x18\x04 \x03(\tR\x12preferredEmployees\x12\x1c\n" +
+	"\tresources\x18\x05 \x03(\tR\tresources\x12,\n" +
+	"\x12allow_self_booking\x18\x06 \x01(\bR\x10allowSelfBooking\"\xbb\x02\n" +
+	"\x06Bundle\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\thelp_text\x18\x03 \x01(\tR\bhelpText\x12(\n" +
+	"\n" +
+	"treatments\x18\x04 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x02R\n" +
+	"treatments\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12,\n" +
+	"\x12allow_self_booking\x18\x06 \x01(\bR\x10allowSelfBooking\x12F\n" +
+	"\teffective\x18\a \x01(\v2(.tkd.treatment.v1alpha.BundleConstraintsR\teffective\".\n" +
+	"\x10GetBundleRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"2\n" +
+	"\x12ListBundlesRequest\x12\x1c\n" +
+	"\ttreatment\x18\x01 \x01(\tR\ttreatment\"N\n" +
+	"\x13ListBundlesResponse\x127\n" +
+	"\abundles\x18\x01 \x03(\v2\x1d.tkd.treatment.v1alpha.BundleR\abundles\"\xad\x01\n" +
+	"\x13UpdateBundleRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12=\n" +
+	"\x06bundle\x18\x02 \x01(\v2\x1d.tkd.treatment.v1alpha.BundleB\x06\xbaH\x03\xc8\x01\x01R\x06bundle\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"1\n" +
+	"\x13DeleteBundleRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name2\xea\x03\n" +
+	"\rBundleService\x12S\n" +
+	"\fCreateBundle\x12\x1d.tkd.treatment.v1alpha.Bundle\x1a\x1d.tkd.treatment.v1alpha.Bundle\"\x05\xb2~\x02\b\x01\x12Z\n" +
+	"\tGetBundle\x12'.tkd.treatment.v1alpha.GetBundleRequest\x1a\x1d.tkd.treatment.v1alpha.Bundle\"\x05\xb2~\x02\b\x01\x12k\n" +
+	"\vListBundles\x12).tkd.treatment.v1alpha.ListBundlesRequest\x1a*.tkd.treatment.v1alpha.ListBundlesResponse\"\x05\xb2~\x02\b\x01\x12`\n" +
+	"\fUpdateBundle\x12*.tkd.treatment.v1alpha.UpdateBundleRequest\x1a\x1d.tkd.treatment.v1alpha.Bundle\"\x05\xb2~\x02\b\x01\x12Y\n" +
+	"\fDeleteBundle\x12*.tkd.treatment.v1alpha.DeleteBundleRequest\x1a\x16.google.protobuf.Empty\"\x05\xb2~\x02\b\x01BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_bundle_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_bundle_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_bundle_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_bundle_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_bundle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_bundle_proto_rawDesc), len(file_tkd_treatment_v1alpha_bundle_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_bundle_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_bundle_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_tkd_treatment_v1alpha_bundle_proto_goTypes = []any{
+	(*BundleConstraints)(nil),     // 0: tkd.treatment.v1alpha.BundleConstraints
+	(*Bundle)(nil),                // 1: tkd.treatment.v1alpha.Bundle
+	(*GetBundleRequest)(nil),      // 2: tkd.treatment.v1alpha.GetBundleRequest
+	(*ListBundlesRequest)(nil),    // 3: tkd.treatment.v1alpha.ListBundlesRequest
+	(*ListBundlesResponse)(nil),   // 4: tkd.treatment.v1alpha.ListBundlesResponse
+	(*UpdateBundleRequest)(nil),   // 5: tkd.treatment.v1alpha.UpdateBundleRequest
+	(*DeleteBundleRequest)(nil),   // 6: tkd.treatment.v1alpha.DeleteBundleRequest
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+}
+var file_tkd_treatment_v1alpha_bundle_proto_depIdxs = []int32{
+	7,  // 0: tkd.treatment.v1alpha.BundleConstraints.duration:type_name -> google.protobuf.Duration
+	7,  // 1: tkd.treatment.v1alpha.Bundle.duration:type_name -> google.protobuf.Duration
+	0,  // 2: tkd.treatment.v1alpha.Bundle.effective:type_name -> tkd.treatment.v1alpha.BundleConstraints
+	1,  // 3: tkd.treatment.v1alpha.ListBundlesResponse.bundles:type_name -> tkd.treatment.v1alpha.Bundle
+	1,  // 4: tkd.treatment.v1alpha.UpdateBundleRequest.bundle:type_name -> tkd.treatment.v1alpha.Bundle
+	8,  // 5: tkd.treatment.v1alpha.UpdateBundleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 6: tkd.treatment.v1alpha.BundleService.CreateBundle:input_type -> tkd.treatment.v1alpha.Bundle
+	2,  // 7: tkd.treatment.v1alpha.BundleService.GetBundle:input_type -> tkd.treatment.v1alpha.GetBundleRequest
+	3,  // 8: tkd.treatment.v1alpha.BundleService.ListBundles:input_type -> tkd.treatment.v1alpha.ListBundlesRequest
+	5,  // 9: tkd.treatment.v1alpha.BundleService.UpdateBundle:input_type -> tkd.treatment.v1alpha.UpdateBundleRequest
+	6,  // 10: tkd.treatment.v1alpha.BundleService.DeleteBundle:input_type -> tkd.treatment.v1alpha.DeleteBundleRequest
+	1,  // 11: tkd.treatment.v1alpha.BundleService.CreateBundle:output_type -> tkd.treatment.v1alpha.Bundle
+	1,  // 12: tkd.treatment.v1alpha.BundleService.GetBundle:output_type -> tkd.treatment.v1alpha.Bundle
+	4,  // 13: tkd.treatment.v1alpha.BundleService.ListBundles:output_type -> tkd.treatment.v1alpha.ListBundlesResponse
+	1,  // 14: tkd.treatment.v1alpha.BundleService.UpdateBundle:output_type -> tkd.treatment.v1alpha.Bundle
+	9,  // 15: tkd.treatment.v1alpha.BundleService.DeleteBundle:output_type -> google.protobuf.Empty
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_bundle_proto_init() }
+func file_tkd_treatment_v1alpha_bundle_proto_init() {
+	if File_tkd_treatment_v1alpha_bundle_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_bundle_proto_rawDesc), len(file_tkd_treatment_v1alpha_bundle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_bundle_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_bundle_proto_depIdxs,
+		MessageInfos:      file_tkd_treatment_v1alpha_bundle_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_bundle_proto = out.File
+	file_tkd_treatment_v1alpha_bundle_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_bundle_proto_depIdxs = nil
+}
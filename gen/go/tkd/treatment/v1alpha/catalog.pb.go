@@ -0,0 +1,371 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/catalog.proto
+
+package treatmentv1alpha
+
+import (
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CatalogTreatment is the public representation of a treatment that is
+// available for self-booking. In contrast to tkd.treatment.v1.Treatment
+// it does not include any internal information like employees or
+// resources.
+type CatalogTreatment struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Name                      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName               string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	HelpText                  string                 `protobuf:"bytes,3,opt,name=help_text,json=helpText,proto3" json:"help_text,omitempty"`
+	InitialTimeRequirement    *durationpb.Duration   `protobuf:"bytes,4,opt,name=initial_time_requirement,json=initialTimeRequirement,proto3" json:"initial_time_requirement,omitempty"`
+	AdditionalTimeRequirement *durationpb.Duration   `protobuf:"bytes,5,opt,name=additional_time_requirement,json=additionalTimeRequirement,proto3" json:"additional_time_requirement,omitempty"`
+	// Price holds the currently valid price of the treatment for the
+	// species. It is only set if the price has been published.
+	Price         *Price `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogTreatment) Reset() {
+	*x = CatalogTreatment{}
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogTreatment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogTreatment) ProtoMessage() {}
+
+func (x *CatalogTreatment) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogTreatment.ProtoReflect.Descriptor instead.
+func (*CatalogTreatment) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CatalogTreatment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogTreatment) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CatalogTreatment) GetHelpText() string {
+	if x != nil {
+		return x.HelpText
+	}
+	return ""
+}
+
+func (x *CatalogTreatment) GetInitialTimeRequirement() *durationpb.Duration {
+	if x != nil {
+		return x.InitialTimeRequirement
+	}
+	return nil
+}
+
+func (x *CatalogTreatment) GetAdditionalTimeRequirement() *durationpb.Duration {
+	if x != nil {
+		return x.AdditionalTimeRequirement
+	}
+	return nil
+}
+
+func (x *CatalogTreatment) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// CatalogSpecies is a species together with all treatments that may be
+// self-booked for it.
+type CatalogSpecies struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName             string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	RequestCastrationStatus bool                   `protobuf:"varint,3,opt,name=request_castration_status,json=requestCastrationStatus,proto3" json:"request_castration_status,omitempty"`
+	Icon                    *v1.Icon               `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Treatments              []*CatalogTreatment    `protobuf:"bytes,5,rep,name=treatments,proto3" json:"treatments,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CatalogSpecies) Reset() {
+	*x = CatalogSpecies{}
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogSpecies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogSpecies) ProtoMessage() {}
+
+func (x *CatalogSpecies) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogSpecies.ProtoReflect.Descriptor instead.
+func (*CatalogSpecies) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CatalogSpecies) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogSpecies) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CatalogSpecies) GetRequestCastrationStatus() bool {
+	if x != nil {
+		return x.RequestCastrationStatus
+	}
+	return false
+}
+
+func (x *CatalogSpecies) GetIcon() *v1.Icon {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *CatalogSpecies) GetTreatments() []*CatalogTreatment {
+	if x != nil {
+		return x.Treatments
+	}
+	return nil
+}
+
+type GetSelfBookingCatalogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Species might be set to only return the catalog for the given species.
+	Species       string `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSelfBookingCatalogRequest) Reset() {
+	*x = GetSelfBookingCatalogRequest{}
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSelfBookingCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSelfBookingCatalogRequest) ProtoMessage() {}
+
+func (x *GetSelfBookingCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSelfBookingCatalogRequest.ProtoReflect.Descriptor instead.
+func (*GetSelfBookingCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSelfBookingCatalogRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+type SelfBookingCatalog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Species       []*CatalogSpecies      `protobuf:"bytes,1,rep,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelfBookingCatalog) Reset() {
+	*x = SelfBookingCatalog{}
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfBookingCatalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfBookingCatalog) ProtoMessage() {}
+
+func (x *SelfBookingCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfBookingCatalog.ProtoReflect.Descriptor instead.
+func (*SelfBookingCatalog) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SelfBookingCatalog) GetSpecies() []*CatalogSpecies {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+var File_tkd_treatment_v1alpha_catalog_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_catalog_proto_rawDesc = "" +
+	"\n" +
+	"#tkd/treatment/v1alpha/catalog.proto\x12\x15tkd.treatment.v1alpha\x1a\x1egoogle/protobuf/duration.proto\x1a\x1etkd/treatment/v1/species.proto\x1a#tkd/treatment/v1alpha/pricing.proto\"\xca\x02\n" +
+	"\x10CatalogTreatment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\thelp_text\x18\x03 \x01(\tR\bhelpText\x12S\n" +
+	"\x18initial_time_requirement\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x16initialTimeRequirement\x12Y\n" +
+	"\x1badditional_time_requirement\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x19additionalTimeRequirement\x122\n" +
+	"\x05price\x18\x06 \x01(\v2\x1c.tkd.treatment.v1alpha.PriceR\x05price\"\xf8\x01\n" +
+	"\x0eCatalogSpecies\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12:\n" +
+	"\x19request_castration_status\x18\x03 \x01(\bR\x17requestCastrationStatus\x12*\n" +
+	"\x04icon\x18\x04 \x01(\v2\x16.tkd.treatment.v1.IconR\x04icon\x12G\n" +
+	"\n" +
+	"treatments\x18\x05 \x03(\v2'.tkd.treatment.v1alpha.CatalogTreatmentR\n" +
+	"treatments\"8\n" +
+	"\x1cGetSelfBookingCatalogRequest\x12\x18\n" +
+	"\aspecies\x18\x01 \x01(\tR\aspecies\"U\n" +
+	"\x12SelfBookingCatalog\x12?\n" +
+	"\aspecies\x18\x01 \x03(\v2%.tkd.treatment.v1alpha.CatalogSpeciesR\aspecies2\x96\x01\n" +
+	"\x19SelfBookingCatalogService\x12y\n" +
+	"\x15GetSelfBookingCatalog\x123.tkd.treatment.v1alpha.GetSelfBookingCatalogRequest\x1a).tkd.treatment.v1alpha.SelfBookingCatalog\"\x00BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_catalog_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_catalog_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_catalog_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_catalog_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_catalog_proto_rawDesc), len(file_tkd_treatment_v1alpha_catalog_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_catalog_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_tkd_treatment_v1alpha_catalog_proto_goTypes = []any{
+	(*CatalogTreatment)(nil),             // 0: tkd.treatment.v1alpha.CatalogTreatment
+	(*CatalogSpecies)(nil),               // 1: tkd.treatment.v1alpha.CatalogSpecies
+	(*GetSelfBookingCatalogRequest)(nil), // 2: tkd.treatment.v1alpha.GetSelfBookingCatalogRequest
+	(*SelfBookingCatalog)(nil),           // 3: tkd.treatment.v1alpha.SelfBookingCatalog
+	(*durationpb.Duration)(nil),          // 4: google.protobuf.Duration
+	(*Price)(nil),                        // 5: tkd.treatment.v1alpha.Price
+	(*v1.Icon)(nil),                      // 6: tkd.treatment.v1.Icon
+}
+var file_tkd_treatment_v1alpha_catalog_proto_depIdxs = []int32{
+	4, // 0: tkd.treatment.v1alpha.CatalogTreatment.initial_time_requirement:type_name -> google.protobuf.Duration
+	4, // 1: tkd.treatment.v1alpha.CatalogTreatment.additional_time_requirement:type_name -> google.protobuf.Duration
+	5, // 2: tkd.treatment.v1alpha.CatalogTreatment.price:type_name -> tkd.treatment.v1alpha.Price
+	6, // 3: tkd.treatment.v1alpha.CatalogSpecies.icon:type_name -> tkd.treatment.v1.Icon
+	0, // 4: tkd.treatment.v1alpha.CatalogSpecies.treatments:type_name -> tkd.treatment.v1alpha.CatalogTreatment
+	1, // 5: tkd.treatment.v1alpha.SelfBookingCatalog.species:type_name -> tkd.treatment.v1alpha.CatalogSpecies
+	2, // 6: tkd.treatment.v1alpha.SelfBookingCatalogService.GetSelfBookingCatalog:input_type -> tkd.treatment.v1alpha.GetSelfBookingCatalogRequest
+	3, // 7: tkd.treatment.v1alpha.SelfBookingCatalogService.GetSelfBookingCatalog:output_type -> tkd.treatment.v1alpha.SelfBookingCatalog
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_catalog_proto_init() }
+func file_tkd_treatment_v1alpha_catalog_proto_init() {
+	if File_tkd_treatment_v1alpha_catalog_proto != nil {
+		return
+	}
+	file_tkd_treatment_v1alpha_pricing_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_catalog_proto_rawDesc), len(file_tkd_treatment_v1alpha_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_catalog_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_catalog_proto_depIdxs,
+		MessageInfos:      file_tkd_treatment_v1alpha_catalog_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_catalog_proto = out.File
+	file_tkd_treatment_v1alpha_catalog_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_catalog_proto_depIdxs = nil
+}
@@ -0,0 +1,1611 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/details.proto
+
+package treatmentv1alpha
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v11 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AnesthesiaType describes the kind of anesthesia required for a treatment.
+type AnesthesiaType int32
+
+const (
+	// The treatment does not require anesthesia.
+	AnesthesiaType_ANESTHESIA_TYPE_UNSPECIFIED AnesthesiaType = 0
+	AnesthesiaType_ANESTHESIA_TYPE_SEDATION    AnesthesiaType = 1
+	AnesthesiaType_ANESTHESIA_TYPE_LOCAL       AnesthesiaType = 2
+	AnesthesiaType_ANESTHESIA_TYPE_GENERAL     AnesthesiaType = 3
+)
+
+// Enum value maps for AnesthesiaType.
+var (
+	AnesthesiaType_name = map[int32]string{
+		0: "ANESTHESIA_TYPE_UNSPECIFIED",
+		1: "ANESTHESIA_TYPE_SEDATION",
+		2: "ANESTHESIA_TYPE_LOCAL",
+		3: "ANESTHESIA_TYPE_GENERAL",
+	}
+	AnesthesiaType_value = map[string]int32{
+		"ANESTHESIA_TYPE_UNSPECIFIED": 0,
+		"ANESTHESIA_TYPE_SEDATION":    1,
+		"ANESTHESIA_TYPE_LOCAL":       2,
+		"ANESTHESIA_TYPE_GENERAL":     3,
+	}
+)
+
+func (x AnesthesiaType) Enum() *AnesthesiaType {
+	p := new(AnesthesiaType)
+	*p = x
+	return p
+}
+
+func (x AnesthesiaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnesthesiaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_details_proto_enumTypes[0].Descriptor()
+}
+
+func (AnesthesiaType) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_details_proto_enumTypes[0]
+}
+
+func (x AnesthesiaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnesthesiaType.Descriptor instead.
+func (AnesthesiaType) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{0}
+}
+
+type Sex int32
+
+const (
+	Sex_SEX_UNSPECIFIED Sex = 0
+	Sex_SEX_MALE        Sex = 1
+	Sex_SEX_FEMALE      Sex = 2
+)
+
+// Enum value maps for Sex.
+var (
+	Sex_name = map[int32]string{
+		0: "SEX_UNSPECIFIED",
+		1: "SEX_MALE",
+		2: "SEX_FEMALE",
+	}
+	Sex_value = map[string]int32{
+		"SEX_UNSPECIFIED": 0,
+		"SEX_MALE":        1,
+		"SEX_FEMALE":      2,
+	}
+)
+
+func (x Sex) Enum() *Sex {
+	p := new(Sex)
+	*p = x
+	return p
+}
+
+func (x Sex) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Sex) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_details_proto_enumTypes[1].Descriptor()
+}
+
+func (Sex) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_details_proto_enumTypes[1]
+}
+
+func (x Sex) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Sex.Descriptor instead.
+func (Sex) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{1}
+}
+
+type CastrationStatus int32
+
+const (
+	CastrationStatus_CASTRATION_STATUS_UNSPECIFIED CastrationStatus = 0
+	CastrationStatus_CASTRATION_STATUS_INTACT      CastrationStatus = 1
+	CastrationStatus_CASTRATION_STATUS_CASTRATED   CastrationStatus = 2
+)
+
+// Enum value maps for CastrationStatus.
+var (
+	CastrationStatus_name = map[int32]string{
+		0: "CASTRATION_STATUS_UNSPECIFIED",
+		1: "CASTRATION_STATUS_INTACT",
+		2: "CASTRATION_STATUS_CASTRATED",
+	}
+	CastrationStatus_value = map[string]int32{
+		"CASTRATION_STATUS_UNSPECIFIED": 0,
+		"CASTRATION_STATUS_INTACT":      1,
+		"CASTRATION_STATUS_CASTRATED":   2,
+	}
+)
+
+func (x CastrationStatus) Enum() *CastrationStatus {
+	p := new(CastrationStatus)
+	*p = x
+	return p
+}
+
+func (x CastrationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CastrationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_details_proto_enumTypes[2].Descriptor()
+}
+
+func (CastrationStatus) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_details_proto_enumTypes[2]
+}
+
+func (x CastrationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CastrationStatus.Descriptor instead.
+func (CastrationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{2}
+}
+
+type AnesthesiaFilter int32
+
+const (
+	// Do not filter by anesthesia.
+	AnesthesiaFilter_ANESTHESIA_FILTER_UNSPECIFIED AnesthesiaFilter = 0
+	// Only return treatments that require anesthesia.
+	AnesthesiaFilter_ANESTHESIA_FILTER_REQUIRED AnesthesiaFilter = 1
+	// Only return treatments that do not require anesthesia.
+	AnesthesiaFilter_ANESTHESIA_FILTER_NOT_REQUIRED AnesthesiaFilter = 2
+)
+
+// Enum value maps for AnesthesiaFilter.
+var (
+	AnesthesiaFilter_name = map[int32]string{
+		0: "ANESTHESIA_FILTER_UNSPECIFIED",
+		1: "ANESTHESIA_FILTER_REQUIRED",
+		2: "ANESTHESIA_FILTER_NOT_REQUIRED",
+	}
+	AnesthesiaFilter_value = map[string]int32{
+		"ANESTHESIA_FILTER_UNSPECIFIED":  0,
+		"ANESTHESIA_FILTER_REQUIRED":     1,
+		"ANESTHESIA_FILTER_NOT_REQUIRED": 2,
+	}
+)
+
+func (x AnesthesiaFilter) Enum() *AnesthesiaFilter {
+	p := new(AnesthesiaFilter)
+	*p = x
+	return p
+}
+
+func (x AnesthesiaFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnesthesiaFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_details_proto_enumTypes[3].Descriptor()
+}
+
+func (AnesthesiaFilter) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_details_proto_enumTypes[3]
+}
+
+func (x AnesthesiaFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnesthesiaFilter.Descriptor instead.
+func (AnesthesiaFilter) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{3}
+}
+
+// Prerequisite requires that one of the listed treatments has been
+// performed before.
+type Prerequisite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Treatments is a list of treatment names. Any of them satisfies
+	// the prerequisite.
+	Treatments []string `protobuf:"bytes,1,rep,name=treatments,proto3" json:"treatments,omitempty"`
+	// MaxAge defines how long a previous treatment satisfies the prerequisite.
+	// If unset, previous treatments are valid forever.
+	MaxAge *durationpb.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// Description is an optional, human readable description of the
+	// prerequisite.
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prerequisite) Reset() {
+	*x = Prerequisite{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prerequisite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prerequisite) ProtoMessage() {}
+
+func (x *Prerequisite) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prerequisite.ProtoReflect.Descriptor instead.
+func (*Prerequisite) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Prerequisite) GetTreatments() []string {
+	if x != nil {
+		return x.Treatments
+	}
+	return nil
+}
+
+func (x *Prerequisite) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *Prerequisite) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// PreOpRequirements describe requirements of surgical treatments that must
+// be respected when scheduling an appointment.
+type PreOpRequirements struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// FastingDuration is the time the patient must not be fed before
+	// the appointment.
+	FastingDuration *durationpb.Duration `protobuf:"bytes,1,opt,name=fasting_duration,json=fastingDuration,proto3" json:"fasting_duration,omitempty"`
+	// Anesthesia is the type of anesthesia required for the treatment.
+	Anesthesia AnesthesiaType `protobuf:"varint,2,opt,name=anesthesia,proto3,enum=tkd.treatment.v1alpha.AnesthesiaType" json:"anesthesia,omitempty"`
+	// LatestStart is the latest time of day the treatment may be started.
+	LatestStart *v1.DayTime `protobuf:"bytes,3,opt,name=latest_start,json=latestStart,proto3" json:"latest_start,omitempty"`
+	// RecoveryTime is the time the patient needs to recover after the
+	// treatment and must be kept free in the calendar.
+	RecoveryTime  *durationpb.Duration `protobuf:"bytes,4,opt,name=recovery_time,json=recoveryTime,proto3" json:"recovery_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreOpRequirements) Reset() {
+	*x = PreOpRequirements{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreOpRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreOpRequirements) ProtoMessage() {}
+
+func (x *PreOpRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreOpRequirements.ProtoReflect.Descriptor instead.
+func (*PreOpRequirements) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreOpRequirements) GetFastingDuration() *durationpb.Duration {
+	if x != nil {
+		return x.FastingDuration
+	}
+	return nil
+}
+
+func (x *PreOpRequirements) GetAnesthesia() AnesthesiaType {
+	if x != nil {
+		return x.Anesthesia
+	}
+	return AnesthesiaType_ANESTHESIA_TYPE_UNSPECIFIED
+}
+
+func (x *PreOpRequirements) GetLatestStart() *v1.DayTime {
+	if x != nil {
+		return x.LatestStart
+	}
+	return nil
+}
+
+func (x *PreOpRequirements) GetRecoveryTime() *durationpb.Duration {
+	if x != nil {
+		return x.RecoveryTime
+	}
+	return nil
+}
+
+// Eligibility restricts the patients a treatment can be performed on.
+type Eligibility struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sexes holds the sexes the treatment applies to. If empty, the
+	// treatment applies to all sexes.
+	Sexes []Sex `protobuf:"varint,1,rep,packed,name=sexes,proto3,enum=tkd.treatment.v1alpha.Sex" json:"sexes,omitempty"`
+	// CastrationStatuses holds the castration statuses the treatment applies
+	// to. If empty, the treatment applies to both, intact and castrated
+	// animals.
+	CastrationStatuses []CastrationStatus `protobuf:"varint,2,rep,packed,name=castration_statuses,json=castrationStatuses,proto3,enum=tkd.treatment.v1alpha.CastrationStatus" json:"castration_statuses,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Eligibility) Reset() {
+	*x = Eligibility{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Eligibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eligibility) ProtoMessage() {}
+
+func (x *Eligibility) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Eligibility.ProtoReflect.Descriptor instead.
+func (*Eligibility) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Eligibility) GetSexes() []Sex {
+	if x != nil {
+		return x.Sexes
+	}
+	return nil
+}
+
+func (x *Eligibility) GetCastrationStatuses() []CastrationStatus {
+	if x != nil {
+		return x.CastrationStatuses
+	}
+	return nil
+}
+
+// PatientAttributes describe the patient a treatment should be
+// performed on.
+type PatientAttributes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sex   Sex                    `protobuf:"varint,1,opt,name=sex,proto3,enum=tkd.treatment.v1alpha.Sex" json:"sex,omitempty"`
+	// CastrationStatus is only considered if the species requests the
+	// castration status.
+	CastrationStatus CastrationStatus `protobuf:"varint,2,opt,name=castration_status,json=castrationStatus,proto3,enum=tkd.treatment.v1alpha.CastrationStatus" json:"castration_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PatientAttributes) Reset() {
+	*x = PatientAttributes{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatientAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatientAttributes) ProtoMessage() {}
+
+func (x *PatientAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatientAttributes.ProtoReflect.Descriptor instead.
+func (*PatientAttributes) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PatientAttributes) GetSex() Sex {
+	if x != nil {
+		return x.Sex
+	}
+	return Sex_SEX_UNSPECIFIED
+}
+
+func (x *PatientAttributes) GetCastrationStatus() CastrationStatus {
+	if x != nil {
+		return x.CastrationStatus
+	}
+	return CastrationStatus_CASTRATION_STATUS_UNSPECIFIED
+}
+
+// TreatmentDetails holds additional information about a treatment that
+// is not (yet) part of tkd.treatment.v1.Treatment.
+type TreatmentDetails struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the name of the treatment.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Prerequisites must all be satisfied before the treatment
+	// can be performed.
+	Prerequisites []*Prerequisite `protobuf:"bytes,2,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	// PreparationInstructions are client facing instructions that are sent
+	// with appointment confirmations and reminders. Instructions may contain
+	// the placeholders {{pet_name}}, {{appointment_date}} and
+	// {{appointment_time}}.
+	PreparationInstructions []string `protobuf:"bytes,3,rep,name=preparation_instructions,json=preparationInstructions,proto3" json:"preparation_instructions,omitempty"`
+	// SpeciesPreparationInstructions replace preparation_instructions for
+	// the given species.
+	SpeciesPreparationInstructions []*SpeciesPreparationInstructions `protobuf:"bytes,4,rep,name=species_preparation_instructions,json=speciesPreparationInstructions,proto3" json:"species_preparation_instructions,omitempty"`
+	// PreOpRequirements holds fasting, anesthesia and scheduling requirements
+	// of the treatment. Unset if the treatment does not have any.
+	PreOpRequirements *PreOpRequirements `protobuf:"bytes,5,opt,name=pre_op_requirements,json=preOpRequirements,proto3" json:"pre_op_requirements,omitempty"`
+	// Eligibility restricts the patients the treatment can be performed on.
+	// Unset if the treatment applies to all patients.
+	Eligibility *Eligibility `protobuf:"bytes,6,opt,name=eligibility,proto3" json:"eligibility,omitempty"`
+	// Template marks the treatment as a template that is only used to
+	// create other treatments using CloneTreatment. Templates cannot be
+	// booked and are hidden from tkd.treatment.v1.TreatmentService.ListTreatments.
+	Template      bool `protobuf:"varint,7,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreatmentDetails) Reset() {
+	*x = TreatmentDetails{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentDetails) ProtoMessage() {}
+
+func (x *TreatmentDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentDetails.ProtoReflect.Descriptor instead.
+func (*TreatmentDetails) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TreatmentDetails) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TreatmentDetails) GetPrerequisites() []*Prerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+func (x *TreatmentDetails) GetPreparationInstructions() []string {
+	if x != nil {
+		return x.PreparationInstructions
+	}
+	return nil
+}
+
+func (x *TreatmentDetails) GetSpeciesPreparationInstructions() []*SpeciesPreparationInstructions {
+	if x != nil {
+		return x.SpeciesPreparationInstructions
+	}
+	return nil
+}
+
+func (x *TreatmentDetails) GetPreOpRequirements() *PreOpRequirements {
+	if x != nil {
+		return x.PreOpRequirements
+	}
+	return nil
+}
+
+func (x *TreatmentDetails) GetEligibility() *Eligibility {
+	if x != nil {
+		return x.Eligibility
+	}
+	return nil
+}
+
+func (x *TreatmentDetails) GetTemplate() bool {
+	if x != nil {
+		return x.Template
+	}
+	return false
+}
+
+// SpeciesPreparationInstructions overwrite the preparation instructions of
+// a treatment for a specific species.
+type SpeciesPreparationInstructions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Species       string                 `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	Instructions  []string               `protobuf:"bytes,2,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeciesPreparationInstructions) Reset() {
+	*x = SpeciesPreparationInstructions{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeciesPreparationInstructions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeciesPreparationInstructions) ProtoMessage() {}
+
+func (x *SpeciesPreparationInstructions) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeciesPreparationInstructions.ProtoReflect.Descriptor instead.
+func (*SpeciesPreparationInstructions) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SpeciesPreparationInstructions) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *SpeciesPreparationInstructions) GetInstructions() []string {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+type GetTreatmentDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTreatmentDetailsRequest) Reset() {
+	*x = GetTreatmentDetailsRequest{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTreatmentDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreatmentDetailsRequest) ProtoMessage() {}
+
+func (x *GetTreatmentDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreatmentDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetTreatmentDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTreatmentDetailsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateTreatmentDetailsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Details *TreatmentDetails      `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	// UpdateMask specifies which fields of details should be updated. If
+	// empty, all fields are updated.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTreatmentDetailsRequest) Reset() {
+	*x = UpdateTreatmentDetailsRequest{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTreatmentDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTreatmentDetailsRequest) ProtoMessage() {}
+
+func (x *UpdateTreatmentDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTreatmentDetailsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTreatmentDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTreatmentDetailsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTreatmentDetailsRequest) GetDetails() *TreatmentDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *UpdateTreatmentDetailsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type ListTreatmentsWithDetailsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Species might be set to only return treatments applicable to the
+	// given species.
+	Species string `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	// DisplayNameSearch works the same as for tkd.treatment.v1.ListTreatmentsRequest.
+	DisplayNameSearch string           `protobuf:"bytes,2,opt,name=display_name_search,json=displayNameSearch,proto3" json:"display_name_search,omitempty"`
+	Anesthesia        AnesthesiaFilter `protobuf:"varint,3,opt,name=anesthesia,proto3,enum=tkd.treatment.v1alpha.AnesthesiaFilter" json:"anesthesia,omitempty"`
+	// StartTime might be set to only return treatments that may be started
+	// at the given time of day.
+	StartTime *v1.DayTime `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Patient might be set to only return treatments the patient is
+	// eligible for.
+	Patient *PatientAttributes `protobuf:"bytes,5,opt,name=patient,proto3" json:"patient,omitempty"`
+	// IncludeTemplates may be set to true to include template treatments.
+	IncludeTemplates bool `protobuf:"varint,6,opt,name=include_templates,json=includeTemplates,proto3" json:"include_templates,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListTreatmentsWithDetailsRequest) Reset() {
+	*x = ListTreatmentsWithDetailsRequest{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTreatmentsWithDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTreatmentsWithDetailsRequest) ProtoMessage() {}
+
+func (x *ListTreatmentsWithDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTreatmentsWithDetailsRequest.ProtoReflect.Descriptor instead.
+func (*ListTreatmentsWithDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTreatmentsWithDetailsRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *ListTreatmentsWithDetailsRequest) GetDisplayNameSearch() string {
+	if x != nil {
+		return x.DisplayNameSearch
+	}
+	return ""
+}
+
+func (x *ListTreatmentsWithDetailsRequest) GetAnesthesia() AnesthesiaFilter {
+	if x != nil {
+		return x.Anesthesia
+	}
+	return AnesthesiaFilter_ANESTHESIA_FILTER_UNSPECIFIED
+}
+
+func (x *ListTreatmentsWithDetailsRequest) GetStartTime() *v1.DayTime {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListTreatmentsWithDetailsRequest) GetPatient() *PatientAttributes {
+	if x != nil {
+		return x.Patient
+	}
+	return nil
+}
+
+func (x *ListTreatmentsWithDetailsRequest) GetIncludeTemplates() bool {
+	if x != nil {
+		return x.IncludeTemplates
+	}
+	return false
+}
+
+type TreatmentWithDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treatment     *v11.Treatment         `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Details       *TreatmentDetails      `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreatmentWithDetails) Reset() {
+	*x = TreatmentWithDetails{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentWithDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentWithDetails) ProtoMessage() {}
+
+func (x *TreatmentWithDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentWithDetails.ProtoReflect.Descriptor instead.
+func (*TreatmentWithDetails) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TreatmentWithDetails) GetTreatment() *v11.Treatment {
+	if x != nil {
+		return x.Treatment
+	}
+	return nil
+}
+
+func (x *TreatmentWithDetails) GetDetails() *TreatmentDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListTreatmentsWithDetailsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Treatments    []*TreatmentWithDetails `protobuf:"bytes,1,rep,name=treatments,proto3" json:"treatments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTreatmentsWithDetailsResponse) Reset() {
+	*x = ListTreatmentsWithDetailsResponse{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTreatmentsWithDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTreatmentsWithDetailsResponse) ProtoMessage() {}
+
+func (x *ListTreatmentsWithDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTreatmentsWithDetailsResponse.ProtoReflect.Descriptor instead.
+func (*ListTreatmentsWithDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTreatmentsWithDetailsResponse) GetTreatments() []*TreatmentWithDetails {
+	if x != nil {
+		return x.Treatments
+	}
+	return nil
+}
+
+// PastTreatment is a treatment that has been performed on a patient.
+type PastTreatment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treatment     string                 `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PastTreatment) Reset() {
+	*x = PastTreatment{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PastTreatment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PastTreatment) ProtoMessage() {}
+
+func (x *PastTreatment) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PastTreatment.ProtoReflect.Descriptor instead.
+func (*PastTreatment) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PastTreatment) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *PastTreatment) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type CheckPrerequisitesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Treatment is the name of the treatment to check.
+	Treatment string `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	// History holds the past treatments of the patient.
+	History []*PastTreatment `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	// Time is the time at which the treatment should be performed.
+	// Defaults to now.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPrerequisitesRequest) Reset() {
+	*x = CheckPrerequisitesRequest{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPrerequisitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPrerequisitesRequest) ProtoMessage() {}
+
+func (x *CheckPrerequisitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPrerequisitesRequest.ProtoReflect.Descriptor instead.
+func (*CheckPrerequisitesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckPrerequisitesRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *CheckPrerequisitesRequest) GetHistory() []*PastTreatment {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *CheckPrerequisitesRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type PrerequisiteResult struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Prerequisite *Prerequisite          `protobuf:"bytes,1,opt,name=prerequisite,proto3" json:"prerequisite,omitempty"`
+	Satisfied    bool                   `protobuf:"varint,2,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	// SatisfiedBy is set to the past treatment that satisfied the
+	// prerequisite.
+	SatisfiedBy   *PastTreatment `protobuf:"bytes,3,opt,name=satisfied_by,json=satisfiedBy,proto3" json:"satisfied_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrerequisiteResult) Reset() {
+	*x = PrerequisiteResult{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrerequisiteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrerequisiteResult) ProtoMessage() {}
+
+func (x *PrerequisiteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrerequisiteResult.ProtoReflect.Descriptor instead.
+func (*PrerequisiteResult) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PrerequisiteResult) GetPrerequisite() *Prerequisite {
+	if x != nil {
+		return x.Prerequisite
+	}
+	return nil
+}
+
+func (x *PrerequisiteResult) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+func (x *PrerequisiteResult) GetSatisfiedBy() *PastTreatment {
+	if x != nil {
+		return x.SatisfiedBy
+	}
+	return nil
+}
+
+type CheckPrerequisitesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Satisfied is true if all prerequisites are satisfied.
+	Satisfied     bool                  `protobuf:"varint,1,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	Results       []*PrerequisiteResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPrerequisitesResponse) Reset() {
+	*x = CheckPrerequisitesResponse{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPrerequisitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPrerequisitesResponse) ProtoMessage() {}
+
+func (x *CheckPrerequisitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPrerequisitesResponse.ProtoReflect.Descriptor instead.
+func (*CheckPrerequisitesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckPrerequisitesResponse) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+func (x *CheckPrerequisitesResponse) GetResults() []*PrerequisiteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RenderInstructionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Treatments holds the names of all treatments of the appointment.
+	Treatments []string `protobuf:"bytes,1,rep,name=treatments,proto3" json:"treatments,omitempty"`
+	// Species is the species of the patient and is used to select
+	// species specific instructions.
+	Species string `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	// PetName is used for the {{pet_name}} placeholder.
+	PetName string `protobuf:"bytes,3,opt,name=pet_name,json=petName,proto3" json:"pet_name,omitempty"`
+	// AppointmentTime is used for the {{appointment_date}} and
+	// {{appointment_time}} placeholders.
+	AppointmentTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=appointment_time,json=appointmentTime,proto3" json:"appointment_time,omitempty"`
+	// TimeZone is the IANA time zone used to format the appointment time.
+	// Defaults to the time zone of the server.
+	TimeZone      string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderInstructionsRequest) Reset() {
+	*x = RenderInstructionsRequest{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderInstructionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderInstructionsRequest) ProtoMessage() {}
+
+func (x *RenderInstructionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderInstructionsRequest.ProtoReflect.Descriptor instead.
+func (*RenderInstructionsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RenderInstructionsRequest) GetTreatments() []string {
+	if x != nil {
+		return x.Treatments
+	}
+	return nil
+}
+
+func (x *RenderInstructionsRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *RenderInstructionsRequest) GetPetName() string {
+	if x != nil {
+		return x.PetName
+	}
+	return ""
+}
+
+func (x *RenderInstructionsRequest) GetAppointmentTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppointmentTime
+	}
+	return nil
+}
+
+func (x *RenderInstructionsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type RenderedInstructions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treatment     string                 `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Instructions  []string               `protobuf:"bytes,2,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderedInstructions) Reset() {
+	*x = RenderedInstructions{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderedInstructions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderedInstructions) ProtoMessage() {}
+
+func (x *RenderedInstructions) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderedInstructions.ProtoReflect.Descriptor instead.
+func (*RenderedInstructions) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RenderedInstructions) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *RenderedInstructions) GetInstructions() []string {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+type RenderInstructionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results holds the rendered instructions for each treatment in the
+	// order of the request.
+	Results []*RenderedInstructions `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Instructions holds the rendered instructions of all treatments
+	// with duplicates removed.
+	Instructions []string `protobuf:"bytes,2,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	// MissingPlaceholders holds the names of all placeholders that are used
+	// by the instructions but have not been provided in the request. They
+	// are rendered as an empty string.
+	MissingPlaceholders []string `protobuf:"bytes,3,rep,name=missing_placeholders,json=missingPlaceholders,proto3" json:"missing_placeholders,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RenderInstructionsResponse) Reset() {
+	*x = RenderInstructionsResponse{}
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderInstructionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderInstructionsResponse) ProtoMessage() {}
+
+func (x *RenderInstructionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_details_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderInstructionsResponse.ProtoReflect.Descriptor instead.
+func (*RenderInstructionsResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_details_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RenderInstructionsResponse) GetResults() []*RenderedInstructions {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *RenderInstructionsResponse) GetInstructions() []string {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+func (x *RenderInstructionsResponse) GetMissingPlaceholders() []string {
+	if x != nil {
+		return x.MissingPlaceholders
+	}
+	return nil
+}
+
+var File_tkd_treatment_v1alpha_details_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_details_proto_rawDesc = "" +
+	"\n" +
+	"#tkd/treatment/v1alpha/details.proto\x12\x15tkd.treatment.v1alpha\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1btkd/common/v1/daytime.proto\x1a\x1etkd/common/v1/descriptor.proto\x1a tkd/treatment/v1/treatment.proto\"\x8e\x01\n" +
+	"\fPrerequisite\x12(\n" +
+	"\n" +
+	"treatments\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\n" +
+	"treatments\x122\n" +
+	"\amax_age\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xa5\x02\n" +
+	"\x11PreOpRequirements\x12D\n" +
+	"\x10fasting_duration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0ffastingDuration\x12O\n" +
+	"\n" +
+	"anesthesia\x18\x02 \x01(\x0e2%.tkd.treatment.v1alpha.AnesthesiaTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"anesthesia\x129\n" +
+	"\flatest_start\x18\x03 \x01(\v2\x16.tkd.common.v1.DayTimeR\vlatestStart\x12>\n" +
+	"\rrecovery_time\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\frecoveryTime\"\xbb\x01\n" +
+	"\vEligibility\x12A\n" +
+	"\x05sexes\x18\x01 \x03(\x0e2\x1a.tkd.treatment.v1alpha.SexB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\x05sexes\x12i\n" +
+	"\x13castration_statuses\x18\x02 \x03(\x0e2'.tkd.treatment.v1alpha.CastrationStatusB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\x12castrationStatuses\"\x97\x01\n" +
+	"\x11PatientAttributes\x12,\n" +
+	"\x03sex\x18\x01 \x01(\x0e2\x1a.tkd.treatment.v1alpha.SexR\x03sex\x12T\n" +
+	"\x11castration_status\x18\x02 \x01(\x0e2'.tkd.treatment.v1alpha.CastrationStatusR\x10castrationStatus\"\xe9\x03\n" +
+	"\x10TreatmentDetails\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12I\n" +
+	"\rprerequisites\x18\x02 \x03(\v2#.tkd.treatment.v1alpha.PrerequisiteR\rprerequisites\x129\n" +
+	"\x18preparation_instructions\x18\x03 \x03(\tR\x17preparationInstructions\x12\x7f\n" +
+	" species_preparation_instructions\x18\x04 \x03(\v25.tkd.treatment.v1alpha.SpeciesPreparationInstructionsR\x1especiesPreparationInstructions\x12X\n" +
+	"\x13pre_op_requirements\x18\x05 \x01(\v2(.tkd.treatment.v1alpha.PreOpRequirementsR\x11preOpRequirements\x12D\n" +
+	"\veligibility\x18\x06 \x01(\v2\".tkd.treatment.v1alpha.EligibilityR\veligibility\x12\x1a\n" +
+	"\btemplate\x18\a \x01(\bR\btemplate\"f\n" +
+	"\x1eSpeciesPreparationInstructions\x12 \n" +
+	"\aspecies\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aspecies\x12\"\n" +
+	"\finstructions\x18\x02 \x03(\tR\finstructions\"8\n" +
+	"\x1aGetTreatmentDetailsRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"\xc3\x01\n" +
+	"\x1dUpdateTreatmentDetailsRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12I\n" +
+	"\adetails\x18\x02 \x01(\v2'.tkd.treatment.v1alpha.TreatmentDetailsB\x06\xbaH\x03\xc8\x01\x01R\adetails\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xdd\x02\n" +
+	" ListTreatmentsWithDetailsRequest\x12\x18\n" +
+	"\aspecies\x18\x01 \x01(\tR\aspecies\x12.\n" +
+	"\x13display_name_search\x18\x02 \x01(\tR\x11displayNameSearch\x12G\n" +
+	"\n" +
+	"anesthesia\x18\x03 \x01(\x0e2'.tkd.treatment.v1alpha.AnesthesiaFilterR\n" +
+	"anesthesia\x125\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x16.tkd.common.v1.DayTimeR\tstartTime\x12B\n" +
+	"\apatient\x18\x05 \x01(\v2(.tkd.treatment.v1alpha.PatientAttributesR\apatient\x12+\n" +
+	"\x11include_templates\x18\x06 \x01(\bR\x10includeTemplates\"\x94\x01\n" +
+	"\x14TreatmentWithDetails\x129\n" +
+	"\ttreatment\x18\x01 \x01(\v2\x1b.tkd.treatment.v1.TreatmentR\ttreatment\x12A\n" +
+	"\adetails\x18\x02 \x01(\v2'.tkd.treatment.v1alpha.TreatmentDetailsR\adetails\"p\n" +
+	"!ListTreatmentsWithDetailsResponse\x12K\n" +
+	"\n" +
+	"treatments\x18\x01 \x03(\v2+.tkd.treatment.v1alpha.TreatmentWithDetailsR\n" +
+	"treatments\"m\n" +
+	"\rPastTreatment\x12$\n" +
+	"\ttreatment\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\ttreatment\x126\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x04time\"\xb1\x01\n" +
+	"\x19CheckPrerequisitesRequest\x12$\n" +
+	"\ttreatment\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\ttreatment\x12>\n" +
+	"\ahistory\x18\x02 \x03(\v2$.tkd.treatment.v1alpha.PastTreatmentR\ahistory\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xc4\x01\n" +
+	"\x12PrerequisiteResult\x12G\n" +
+	"\fprerequisite\x18\x01 \x01(\v2#.tkd.treatment.v1alpha.PrerequisiteR\fprerequisite\x12\x1c\n" +
+	"\tsatisfied\x18\x02 \x01(\bR\tsatisfied\x12G\n" +
+	"\fsatisfied_by\x18\x03 \x01(\v2$.tkd.treatment.v1alpha.PastTreatmentR\vsatisfiedBy\"\x7f\n" +
+	"\x1aCheckPrerequisitesResponse\x12\x1c\n" +
+	"\tsatisfied\x18\x01 \x01(\bR\tsatisfied\x12C\n" +
+	"\aresults\x18\x02 \x03(\v2).tkd.treatment.v1alpha.PrerequisiteResultR\aresults\"\xde\x01\n" +
+	"\x19RenderInstructionsRequest\x12(\n" +
+	"\n" +
+	"treatments\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\n" +
+	"treatments\x12\x18\n" +
+	"\aspecies\x18\x02 \x01(\tR\aspecies\x12\x19\n" +
+	"\bpet_name\x18\x03 \x01(\tR\apetName\x12E\n" +
+	"\x10appointment_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fappointmentTime\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\"X\n" +
+	"\x14RenderedInstructions\x12\x1c\n" +
+	"\ttreatment\x18\x01 \x01(\tR\ttreatment\x12\"\n" +
+	"\finstructions\x18\x02 \x03(\tR\finstructions\"\xba\x01\n" +
+	"\x1aRenderInstructionsResponse\x12E\n" +
+	"\aresults\x18\x01 \x03(\v2+.tkd.treatment.v1alpha.RenderedInstructionsR\aresults\x12\"\n" +
+	"\finstructions\x18\x02 \x03(\tR\finstructions\x121\n" +
+	"\x14missing_placeholders\x18\x03 \x03(\tR\x13missingPlaceholders*\x87\x01\n" +
+	"\x0eAnesthesiaType\x12\x1f\n" +
+	"\x1bANESTHESIA_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ANESTHESIA_TYPE_SEDATION\x10\x01\x12\x19\n" +
+	"\x15ANESTHESIA_TYPE_LOCAL\x10\x02\x12\x1b\n" +
+	"\x17ANESTHESIA_TYPE_GENERAL\x10\x03*8\n" +
+	"\x03Sex\x12\x13\n" +
+	"\x0fSEX_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSEX_MALE\x10\x01\x12\x0e\n" +
+	"\n" +
+	"SEX_FEMALE\x10\x02*t\n" +
+	"\x10CastrationStatus\x12!\n" +
+	"\x1dCASTRATION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CASTRATION_STATUS_INTACT\x10\x01\x12\x1f\n" +
+	"\x1bCASTRATION_STATUS_CASTRATED\x10\x02*y\n" +
+	"\x10AnesthesiaFilter\x12!\n" +
+	"\x1dANESTHESIA_FILTER_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aANESTHESIA_FILTER_REQUIRED\x10\x01\x12\"\n" +
+	"\x1eANESTHESIA_FILTER_NOT_REQUIRED\x10\x022\xb1\x05\n" +
+	"\x17TreatmentDetailsService\x12x\n" +
+	"\x13GetTreatmentDetails\x121.tkd.treatment.v1alpha.GetTreatmentDetailsRequest\x1a'.tkd.treatment.v1alpha.TreatmentDetails\"\x05\xb2~\x02\b\x01\x12\x95\x01\n" +
+	"\x19ListTreatmentsWithDetails\x127.tkd.treatment.v1alpha.ListTreatmentsWithDetailsRequest\x1a8.tkd.treatment.v1alpha.ListTreatmentsWithDetailsResponse\"\x05\xb2~\x02\b\x01\x12~\n" +
+	"\x16UpdateTreatmentDetails\x124.tkd.treatment.v1alpha.UpdateTreatmentDetailsRequest\x1a'.tkd.treatment.v1alpha.TreatmentDetails\"\x05\xb2~\x02\b\x01\x12\x80\x01\n" +
+	"\x12CheckPrerequisites\x120.tkd.treatment.v1alpha.CheckPrerequisitesRequest\x1a1.tkd.treatment.v1alpha.CheckPrerequisitesResponse\"\x05\xb2~\x02\b\x01\x12\x80\x01\n" +
+	"\x12RenderInstructions\x120.tkd.treatment.v1alpha.RenderInstructionsRequest\x1a1.tkd.treatment.v1alpha.RenderInstructionsResponse\"\x05\xb2~\x02\b\x01BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_details_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_details_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_details_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_details_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_details_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_details_proto_rawDesc), len(file_tkd_treatment_v1alpha_details_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_details_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_details_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_tkd_treatment_v1alpha_details_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_tkd_treatment_v1alpha_details_proto_goTypes = []any{
+	(AnesthesiaType)(0),                       // 0: tkd.treatment.v1alpha.AnesthesiaType
+	(Sex)(0),                                  // 1: tkd.treatment.v1alpha.Sex
+	(CastrationStatus)(0),                     // 2: tkd.treatment.v1alpha.CastrationStatus
+	(AnesthesiaFilter)(0),                     // 3: tkd.treatment.v1alpha.AnesthesiaFilter
+	(*Prerequisite)(nil),                      // 4: tkd.treatment.v1alpha.Prerequisite
+	(*PreOpRequirements)(nil),                 // 5: tkd.treatment.v1alpha.PreOpRequirements
+	(*Eligibility)(nil),                       // 6: tkd.treatment.v1alpha.Eligibility
+	(*PatientAttributes)(nil),                 // 7: tkd.treatment.v1alpha.PatientAttributes
+	(*TreatmentDetails)(nil),                  // 8: tkd.treatment.v1alpha.TreatmentDetails
+	(*SpeciesPreparationInstructions)(nil),    // 9: tkd.treatment.v1alpha.SpeciesPreparationInstructions
+	(*GetTreatmentDetailsRequest)(nil),        // 10: tkd.treatment.v1alpha.GetTreatmentDetailsRequest
+	(*UpdateTreatmentDetailsRequest)(nil),     // 11: tkd.treatment.v1alpha.UpdateTreatmentDetailsRequest
+	(*ListTreatmentsWithDetailsRequest)(nil),  // 12: tkd.treatment.v1alpha.ListTreatmentsWithDetailsRequest
+	(*TreatmentWithDetails)(nil),              // 13: tkd.treatment.v1alpha.TreatmentWithDetails
+	(*ListTreatmentsWithDetailsResponse)(nil), // 14: tkd.treatment.v1alpha.ListTreatmentsWithDetailsResponse
+	(*PastTreatment)(nil),                     // 15: tkd.treatment.v1alpha.PastTreatment
+	(*CheckPrerequisitesRequest)(nil),         // 16: tkd.treatment.v1alpha.CheckPrerequisitesRequest
+	(*PrerequisiteResult)(nil),                // 17: tkd.treatment.v1alpha.PrerequisiteResult
+	(*CheckPrerequisitesResponse)(nil),        // 18: tkd.treatment.v1alpha.CheckPrerequisitesResponse
+	(*RenderInstructionsRequest)(nil),         // 19: tkd.treatment.v1alpha.RenderInstructionsRequest
+	(*RenderedInstructions)(nil),              // 20: tkd.treatment.v1alpha.RenderedInstructions
+	(*RenderInstructionsResponse)(nil),        // 21: tkd.treatment.v1alpha.RenderInstructionsResponse
+	(*durationpb.Duration)(nil),               // 22: google.protobuf.Duration
+	(*v1.DayTime)(nil),                        // 23: tkd.common.v1.DayTime
+	(*fieldmaskpb.FieldMask)(nil),             // 24: google.protobuf.FieldMask
+	(*v11.Treatment)(nil),                     // 25: tkd.treatment.v1.Treatment
+	(*timestamppb.Timestamp)(nil),             // 26: google.protobuf.Timestamp
+}
+var file_tkd_treatment_v1alpha_details_proto_depIdxs = []int32{
+	22, // 0: tkd.treatment.v1alpha.Prerequisite.max_age:type_name -> google.protobuf.Duration
+	22, // 1: tkd.treatment.v1alpha.PreOpRequirements.fasting_duration:type_name -> google.protobuf.Duration
+	0,  // 2: tkd.treatment.v1alpha.PreOpRequirements.anesthesia:type_name -> tkd.treatment.v1alpha.AnesthesiaType
+	23, // 3: tkd.treatment.v1alpha.PreOpRequirements.latest_start:type_name -> tkd.common.v1.DayTime
+	22, // 4: tkd.treatment.v1alpha.PreOpRequirements.recovery_time:type_name -> google.protobuf.Duration
+	1,  // 5: tkd.treatment.v1alpha.Eligibility.sexes:type_name -> tkd.treatment.v1alpha.Sex
+	2,  // 6: tkd.treatment.v1alpha.Eligibility.castration_statuses:type_name -> tkd.treatment.v1alpha.CastrationStatus
+	1,  // 7: tkd.treatment.v1alpha.PatientAttributes.sex:type_name -> tkd.treatment.v1alpha.Sex
+	2,  // 8: tkd.treatment.v1alpha.PatientAttributes.castration_status:type_name -> tkd.treatment.v1alpha.CastrationStatus
+	4,  // 9: tkd.treatment.v1alpha.TreatmentDetails.prerequisites:type_name -> tkd.treatment.v1alpha.Prerequisite
+	9,  // 10: tkd.treatment.v1alpha.TreatmentDetails.species_preparation_instructions:type_name -> tkd.treatment.v1alpha.SpeciesPreparationInstructions
+	5,  // 11: tkd.treatment.v1alpha.TreatmentDetails.pre_op_requirements:type_name -> tkd.treatment.v1alpha.PreOpRequirements
+	6,  // 12: tkd.treatment.v1alpha.TreatmentDetails.eligibility:type_name -> tkd.treatment.v1alpha.Eligibility
+	8,  // 13: tkd.treatment.v1alpha.UpdateTreatmentDetailsRequest.details:type_name -> tkd.treatment.v1alpha.TreatmentDetails
+	24, // 14: tkd.treatment.v1alpha.UpdateTreatmentDetailsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 15: tkd.treatment.v1alpha.ListTreatmentsWithDetailsRequest.anesthesia:type_name -> tkd.treatment.v1alpha.AnesthesiaFilter
+	23, // 16: tkd.treatment.v1alpha.ListTreatmentsWithDetailsRequest.start_time:type_name -> tkd.common.v1.DayTime
+	7,  // 17: tkd.treatment.v1alpha.ListTreatmentsWithDetailsRequest.patient:type_name -> tkd.treatment.v1alpha.PatientAttributes
+	25, // 18: tkd.treatment.v1alpha.TreatmentWithDetails.treatment:type_name -> tkd.treatment.v1.Treatment
+	8,  // 19: tkd.treatment.v1alpha.TreatmentWithDetails.details:type_name -> tkd.treatment.v1alpha.TreatmentDetails
+	13, // 20: tkd.treatment.v1alpha.ListTreatmentsWithDetailsResponse.treatments:type_name -> tkd.treatment.v1alpha.TreatmentWithDetails
+	26, // 21: tkd.treatment.v1alpha.PastTreatment.time:type_name -> google.protobuf.Timestamp
+	15, // 22: tkd.treatment.v1alpha.CheckPrerequisitesRequest.history:type_name -> tkd.treatment.v1alpha.PastTreatment
+	26, // 23: tkd.treatment.v1alpha.CheckPrerequisitesRequest.time:type_name -> google.protobuf.Timestamp
+	4,  // 24: tkd.treatment.v1alpha.PrerequisiteResult.prerequisite:type_name -> tkd.treatment.v1alpha.Prerequisite
+	15, // 25: tkd.treatment.v1alpha.PrerequisiteResult.satisfied_by:type_name -> tkd.treatment.v1alpha.PastTreatment
+	17, // 26: tkd.treatment.v1alpha.CheckPrerequisitesResponse.results:type_name -> tkd.treatment.v1alpha.PrerequisiteResult
+	26, // 27: tkd.treatment.v1alpha.RenderInstructionsRequest.appointment_time:type_name -> google.protobuf.Timestamp
+	20, // 28: tkd.treatment.v1alpha.RenderInstructionsResponse.results:type_name -> tkd.treatment.v1alpha.RenderedInstructions
+	10, // 29: tkd.treatment.v1alpha.TreatmentDetailsService.GetTreatmentDetails:input_type -> tkd.treatment.v1alpha.GetTreatmentDetailsRequest
+	12, // 30: tkd.treatment.v1alpha.TreatmentDetailsService.ListTreatmentsWithDetails:input_type -> tkd.treatment.v1alpha.ListTreatmentsWithDetailsRequest
+	11, // 31: tkd.treatment.v1alpha.TreatmentDetailsService.UpdateTreatmentDetails:input_type -> tkd.treatment.v1alpha.UpdateTreatmentDetailsRequest
+	16, // 32: tkd.treatment.v1alpha.TreatmentDetailsService.CheckPrerequisites:input_type -> tkd.treatment.v1alpha.CheckPrerequisitesRequest
+	19, // 33: tkd.treatment.v1alpha.TreatmentDetailsService.RenderInstructions:input_type -> tkd.treatment.v1alpha.RenderInstructionsRequest
+	8,  // 34: tkd.treatment.v1alpha.TreatmentDetailsService.GetTreatmentDetails:output_type -> tkd.treatment.v1alpha.TreatmentDetails
+	14, // 35: tkd.treatment.v1alpha.TreatmentDetailsService.ListTreatmentsWithDetails:output_type -> tkd.treatment.v1alpha.ListTreatmentsWithDetailsResponse
+	8,  // 36: tkd.treatment.v1alpha.TreatmentDetailsService.UpdateTreatmentDetails:output_type -> tkd.treatment.v1alpha.TreatmentDetails
+	18, // 37: tkd.treatment.v1alpha.TreatmentDetailsService.CheckPrerequisites:output_type -> tkd.treatment.v1alpha.CheckPrerequisitesResponse
+	21, // 38: tkd.treatment.v1alpha.TreatmentDetailsService.RenderInstructions:output_type -> tkd.treatment.v1alpha.RenderInstructionsResponse
+	34, // [34:39] is the sub-list for method output_type
+	29, // [29:34] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_details_proto_init() }
+func file_tkd_treatment_v1alpha_details_proto_init() {
+	if File_tkd_treatment_v1alpha_details_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_details_proto_rawDesc), len(file_tkd_treatment_v1alpha_details_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_details_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_details_proto_depIdxs,
+		EnumInfos:         file_tkd_treatment_v1alpha_details_proto_enumTypes,
+		MessageInfos:      file_tkd_treatment_v1alpha_details_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_details_proto = out.File
+	file_tkd_treatment_v1alpha_details_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_details_proto_depIdxs = nil
+}
@@ -0,0 +1,1279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/detection.proto
+
+package treatmentv1alpha
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MatchSource describes which field of an entity produced a match.
+type MatchSource int32
+
+const (
+	MatchSource_MATCH_SOURCE_UNSPECIFIED      MatchSource = 0
+	MatchSource_MATCH_SOURCE_MATCH_EVENT_TEXT MatchSource = 1
+	MatchSource_MATCH_SOURCE_DISPLAY_NAME     MatchSource = 2
+)
+
+// Enum value maps for MatchSource.
+var (
+	MatchSource_name = map[int32]string{
+		0: "MATCH_SOURCE_UNSPECIFIED",
+		1: "MATCH_SOURCE_MATCH_EVENT_TEXT",
+		2: "MATCH_SOURCE_DISPLAY_NAME",
+	}
+	MatchSource_value = map[string]int32{
+		"MATCH_SOURCE_UNSPECIFIED":      0,
+		"MATCH_SOURCE_MATCH_EVENT_TEXT": 1,
+		"MATCH_SOURCE_DISPLAY_NAME":     2,
+	}
+)
+
+func (x MatchSource) Enum() *MatchSource {
+	p := new(MatchSource)
+	*p = x
+	return p
+}
+
+func (x MatchSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_detection_proto_enumTypes[0].Descriptor()
+}
+
+func (MatchSource) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_detection_proto_enumTypes[0]
+}
+
+func (x MatchSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchSource.Descriptor instead.
+func (MatchSource) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{0}
+}
+
+// MatchRuleIssueKind describes the kind of problem found with a match rule.
+type MatchRuleIssueKind int32
+
+const (
+	MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_UNSPECIFIED MatchRuleIssueKind = 0
+	// The same match word is used by two different entities.
+	MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_DUPLICATE MatchRuleIssueKind = 1
+	// Every value that matches the match word also matches the conflicting
+	// match word of another entity.
+	MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SUBSUMED MatchRuleIssueKind = 2
+	// The match word contains another match word of the same entity. Every
+	// value matching it also matches the shorter one so it is redundant.
+	MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SHADOWED MatchRuleIssueKind = 3
+	// The entity does not have any match words and can never be detected.
+	MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_NO_MATCH_WORDS MatchRuleIssueKind = 4
+	// The match word is empty and matches any value.
+	MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_BLANK_MATCH_WORD MatchRuleIssueKind = 5
+)
+
+// Enum value maps for MatchRuleIssueKind.
+var (
+	MatchRuleIssueKind_name = map[int32]string{
+		0: "MATCH_RULE_ISSUE_KIND_UNSPECIFIED",
+		1: "MATCH_RULE_ISSUE_KIND_DUPLICATE",
+		2: "MATCH_RULE_ISSUE_KIND_SUBSUMED",
+		3: "MATCH_RULE_ISSUE_KIND_SHADOWED",
+		4: "MATCH_RULE_ISSUE_KIND_NO_MATCH_WORDS",
+		5: "MATCH_RULE_ISSUE_KIND_BLANK_MATCH_WORD",
+	}
+	MatchRuleIssueKind_value = map[string]int32{
+		"MATCH_RULE_ISSUE_KIND_UNSPECIFIED":      0,
+		"MATCH_RULE_ISSUE_KIND_DUPLICATE":        1,
+		"MATCH_RULE_ISSUE_KIND_SUBSUMED":         2,
+		"MATCH_RULE_ISSUE_KIND_SHADOWED":         3,
+		"MATCH_RULE_ISSUE_KIND_NO_MATCH_WORDS":   4,
+		"MATCH_RULE_ISSUE_KIND_BLANK_MATCH_WORD": 5,
+	}
+)
+
+func (x MatchRuleIssueKind) Enum() *MatchRuleIssueKind {
+	p := new(MatchRuleIssueKind)
+	*p = x
+	return p
+}
+
+func (x MatchRuleIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchRuleIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_detection_proto_enumTypes[1].Descriptor()
+}
+
+func (MatchRuleIssueKind) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_detection_proto_enumTypes[1]
+}
+
+func (x MatchRuleIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchRuleIssueKind.Descriptor instead.
+func (MatchRuleIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{1}
+}
+
+type EntityKind int32
+
+const (
+	EntityKind_ENTITY_KIND_UNSPECIFIED EntityKind = 0
+	EntityKind_ENTITY_KIND_SPECIES     EntityKind = 1
+	EntityKind_ENTITY_KIND_TREATMENT   EntityKind = 2
+	EntityKind_ENTITY_KIND_BREED       EntityKind = 3
+)
+
+// Enum value maps for EntityKind.
+var (
+	EntityKind_name = map[int32]string{
+		0: "ENTITY_KIND_UNSPECIFIED",
+		1: "ENTITY_KIND_SPECIES",
+		2: "ENTITY_KIND_TREATMENT",
+		3: "ENTITY_KIND_BREED",
+	}
+	EntityKind_value = map[string]int32{
+		"ENTITY_KIND_UNSPECIFIED": 0,
+		"ENTITY_KIND_SPECIES":     1,
+		"ENTITY_KIND_TREATMENT":   2,
+		"ENTITY_KIND_BREED":       3,
+	}
+)
+
+func (x EntityKind) Enum() *EntityKind {
+	p := new(EntityKind)
+	*p = x
+	return p
+}
+
+func (x EntityKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_detection_proto_enumTypes[2].Descriptor()
+}
+
+func (EntityKind) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_detection_proto_enumTypes[2]
+}
+
+func (x EntityKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityKind.Descriptor instead.
+func (EntityKind) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{2}
+}
+
+// MatchExplanation explains why a single input value matched an entity.
+type MatchExplanation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value is the input value that matched.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// MatchWord is the configured match word (or display name) that
+	// was found in value.
+	MatchWord string `protobuf:"bytes,2,opt,name=match_word,json=matchWord,proto3" json:"match_word,omitempty"`
+	// Source describes where match_word is configured.
+	Source MatchSource `protobuf:"varint,3,opt,name=source,proto3,enum=tkd.treatment.v1alpha.MatchSource" json:"source,omitempty"`
+	// Exact is set to true if match_word is contained in value as is. Otherwise,
+	// the match has been found using fuzzy matching.
+	Exact bool `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`
+	// Distance is the edit distance between match_word and value for fuzzy
+	// matches.
+	Distance      int32 `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchExplanation) Reset() {
+	*x = MatchExplanation{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchExplanation) ProtoMessage() {}
+
+func (x *MatchExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchExplanation.ProtoReflect.Descriptor instead.
+func (*MatchExplanation) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MatchExplanation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MatchExplanation) GetMatchWord() string {
+	if x != nil {
+		return x.MatchWord
+	}
+	return ""
+}
+
+func (x *MatchExplanation) GetSource() MatchSource {
+	if x != nil {
+		return x.Source
+	}
+	return MatchSource_MATCH_SOURCE_UNSPECIFIED
+}
+
+func (x *MatchExplanation) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *MatchExplanation) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// TreatmentMatch is a treatment that has been detected in the input values.
+type TreatmentMatch struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Treatment *v1.Treatment          `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	// ExactMatches is the number of exact matches for the treatment.
+	ExactMatches int32 `protobuf:"varint,2,opt,name=exact_matches,json=exactMatches,proto3" json:"exact_matches,omitempty"`
+	// FuzzyMatches is the number of fuzzy matches for the treatment.
+	FuzzyMatches int32 `protobuf:"varint,3,opt,name=fuzzy_matches,json=fuzzyMatches,proto3" json:"fuzzy_matches,omitempty"`
+	// Explanations lists all matches that contributed to the result.
+	Explanations  []*MatchExplanation `protobuf:"bytes,4,rep,name=explanations,proto3" json:"explanations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreatmentMatch) Reset() {
+	*x = TreatmentMatch{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentMatch) ProtoMessage() {}
+
+func (x *TreatmentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentMatch.ProtoReflect.Descriptor instead.
+func (*TreatmentMatch) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TreatmentMatch) GetTreatment() *v1.Treatment {
+	if x != nil {
+		return x.Treatment
+	}
+	return nil
+}
+
+func (x *TreatmentMatch) GetExactMatches() int32 {
+	if x != nil {
+		return x.ExactMatches
+	}
+	return 0
+}
+
+func (x *TreatmentMatch) GetFuzzyMatches() int32 {
+	if x != nil {
+		return x.FuzzyMatches
+	}
+	return 0
+}
+
+func (x *TreatmentMatch) GetExplanations() []*MatchExplanation {
+	if x != nil {
+		return x.Explanations
+	}
+	return nil
+}
+
+type DetectTreatmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Values holds the free-text input like a calendar event summary
+	// or a customer message.
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	// Species might be set to only consider treatments that are
+	// applicable to one of the given species.
+	Species []string `protobuf:"bytes,2,rep,name=species,proto3" json:"species,omitempty"`
+	// DetectSpecies can be set to true to detect the species from values
+	// and only consider treatments applicable to the detected species.
+	// If no species is detected, no treatments are returned.
+	// This is ignored if species is set.
+	DetectSpecies bool `protobuf:"varint,3,opt,name=detect_species,json=detectSpecies,proto3" json:"detect_species,omitempty"`
+	// Fuzzy enables typo-tolerant matching even if it's disabled in the
+	// service configuration.
+	Fuzzy         bool `protobuf:"varint,4,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTreatmentsRequest) Reset() {
+	*x = DetectTreatmentsRequest{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTreatmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTreatmentsRequest) ProtoMessage() {}
+
+func (x *DetectTreatmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTreatmentsRequest.ProtoReflect.Descriptor instead.
+func (*DetectTreatmentsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DetectTreatmentsRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DetectTreatmentsRequest) GetSpecies() []string {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *DetectTreatmentsRequest) GetDetectSpecies() bool {
+	if x != nil {
+		return x.DetectSpecies
+	}
+	return false
+}
+
+func (x *DetectTreatmentsRequest) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+type DetectTreatmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matches holds all matching treatments, the best match first.
+	Matches []*TreatmentMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Species is the list of species that has been used to constrain
+	// the result, if any.
+	Species       []string `protobuf:"bytes,2,rep,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTreatmentsResponse) Reset() {
+	*x = DetectTreatmentsResponse{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTreatmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTreatmentsResponse) ProtoMessage() {}
+
+func (x *DetectTreatmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTreatmentsResponse.ProtoReflect.Descriptor instead.
+func (*DetectTreatmentsResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DetectTreatmentsResponse) GetMatches() []*TreatmentMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *DetectTreatmentsResponse) GetSpecies() []string {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+// MatchRuleIssue describes a single problem with the configured match rules.
+type MatchRuleIssue struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Kind       MatchRuleIssueKind     `protobuf:"varint,1,opt,name=kind,proto3,enum=tkd.treatment.v1alpha.MatchRuleIssueKind" json:"kind,omitempty"`
+	EntityKind EntityKind             `protobuf:"varint,2,opt,name=entity_kind,json=entityKind,proto3,enum=tkd.treatment.v1alpha.EntityKind" json:"entity_kind,omitempty"`
+	// Entity is the name of the species, treatment or breed the issue
+	// belongs to.
+	Entity string `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// MatchWord is the affected match word, if any.
+	MatchWord string `protobuf:"bytes,4,opt,name=match_word,json=matchWord,proto3" json:"match_word,omitempty"`
+	// ConflictingEntity is the name of the entity that conflicts with entity.
+	// It is equal to entity for MATCH_RULE_ISSUE_KIND_SHADOWED.
+	ConflictingEntity string `protobuf:"bytes,5,opt,name=conflicting_entity,json=conflictingEntity,proto3" json:"conflicting_entity,omitempty"`
+	// ConflictingMatchWord is the match word of conflicting_entity.
+	ConflictingMatchWord string `protobuf:"bytes,6,opt,name=conflicting_match_word,json=conflictingMatchWord,proto3" json:"conflicting_match_word,omitempty"`
+	// Species is set for treatment issues and holds the species for which
+	// both treatments are applicable. It is empty if both treatments apply
+	// to all species. For breed issues, it holds the species of both breeds.
+	Species string `protobuf:"bytes,7,opt,name=species,proto3" json:"species,omitempty"`
+	// Description is a human readable description of the issue.
+	Description   string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchRuleIssue) Reset() {
+	*x = MatchRuleIssue{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchRuleIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRuleIssue) ProtoMessage() {}
+
+func (x *MatchRuleIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRuleIssue.ProtoReflect.Descriptor instead.
+func (*MatchRuleIssue) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MatchRuleIssue) GetKind() MatchRuleIssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *MatchRuleIssue) GetEntityKind() EntityKind {
+	if x != nil {
+		return x.EntityKind
+	}
+	return EntityKind_ENTITY_KIND_UNSPECIFIED
+}
+
+func (x *MatchRuleIssue) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *MatchRuleIssue) GetMatchWord() string {
+	if x != nil {
+		return x.MatchWord
+	}
+	return ""
+}
+
+func (x *MatchRuleIssue) GetConflictingEntity() string {
+	if x != nil {
+		return x.ConflictingEntity
+	}
+	return ""
+}
+
+func (x *MatchRuleIssue) GetConflictingMatchWord() string {
+	if x != nil {
+		return x.ConflictingMatchWord
+	}
+	return ""
+}
+
+func (x *MatchRuleIssue) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *MatchRuleIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type AnalyzeMatchRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeMatchRulesRequest) Reset() {
+	*x = AnalyzeMatchRulesRequest{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeMatchRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeMatchRulesRequest) ProtoMessage() {}
+
+func (x *AnalyzeMatchRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeMatchRulesRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeMatchRulesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{5}
+}
+
+type AnalyzeMatchRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*MatchRuleIssue      `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeMatchRulesResponse) Reset() {
+	*x = AnalyzeMatchRulesResponse{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeMatchRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeMatchRulesResponse) ProtoMessage() {}
+
+func (x *AnalyzeMatchRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeMatchRulesResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeMatchRulesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AnalyzeMatchRulesResponse) GetIssues() []*MatchRuleIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+// DetectionFixture is a labelled example that is used to guard changes
+// to match rules.
+type DetectionFixture struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the unique name of the fixture.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is an optional, human readable description.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Values holds the input values just like
+	// tkd.treatment.v1.DetectSpeciesRequest.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	// ExpectedSpecies lists all species that must be detected. The best
+	// match must be one of them. If empty, species detection is not
+	// evaluated.
+	ExpectedSpecies []string `protobuf:"bytes,4,rep,name=expected_species,json=expectedSpecies,proto3" json:"expected_species,omitempty"`
+	// ExpectedTreatments lists all treatments that must be detected. The best
+	// match must be one of them. If empty, treatment detection is not
+	// evaluated.
+	ExpectedTreatments []string `protobuf:"bytes,5,rep,name=expected_treatments,json=expectedTreatments,proto3" json:"expected_treatments,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DetectionFixture) Reset() {
+	*x = DetectionFixture{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectionFixture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectionFixture) ProtoMessage() {}
+
+func (x *DetectionFixture) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectionFixture.ProtoReflect.Descriptor instead.
+func (*DetectionFixture) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DetectionFixture) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetectionFixture) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DetectionFixture) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DetectionFixture) GetExpectedSpecies() []string {
+	if x != nil {
+		return x.ExpectedSpecies
+	}
+	return nil
+}
+
+func (x *DetectionFixture) GetExpectedTreatments() []string {
+	if x != nil {
+		return x.ExpectedTreatments
+	}
+	return nil
+}
+
+// DetectionFixtureResult is the result of evaluating a detection fixture.
+type DetectionFixtureResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed             bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	DetectedSpecies    []string               `protobuf:"bytes,3,rep,name=detected_species,json=detectedSpecies,proto3" json:"detected_species,omitempty"`
+	DetectedTreatments []string               `protobuf:"bytes,4,rep,name=detected_treatments,json=detectedTreatments,proto3" json:"detected_treatments,omitempty"`
+	// Failures holds a human readable description for each failed
+	// expectation.
+	Failures      []string `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectionFixtureResult) Reset() {
+	*x = DetectionFixtureResult{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectionFixtureResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectionFixtureResult) ProtoMessage() {}
+
+func (x *DetectionFixtureResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectionFixtureResult.ProtoReflect.Descriptor instead.
+func (*DetectionFixtureResult) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DetectionFixtureResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetectionFixtureResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *DetectionFixtureResult) GetDetectedSpecies() []string {
+	if x != nil {
+		return x.DetectedSpecies
+	}
+	return nil
+}
+
+func (x *DetectionFixtureResult) GetDetectedTreatments() []string {
+	if x != nil {
+		return x.DetectedTreatments
+	}
+	return nil
+}
+
+func (x *DetectionFixtureResult) GetFailures() []string {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// DetectionFixtureRegressions is attached as an error detail if a change
+// to match rules causes previously passing fixtures to fail.
+type DetectionFixtureRegressions struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Regressions   []*DetectionFixtureResult `protobuf:"bytes,1,rep,name=regressions,proto3" json:"regressions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectionFixtureRegressions) Reset() {
+	*x = DetectionFixtureRegressions{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectionFixtureRegressions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectionFixtureRegressions) ProtoMessage() {}
+
+func (x *DetectionFixtureRegressions) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectionFixtureRegressions.ProtoReflect.Descriptor instead.
+func (*DetectionFixtureRegressions) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DetectionFixtureRegressions) GetRegressions() []*DetectionFixtureResult {
+	if x != nil {
+		return x.Regressions
+	}
+	return nil
+}
+
+type ListDetectionFixturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDetectionFixturesRequest) Reset() {
+	*x = ListDetectionFixturesRequest{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectionFixturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectionFixturesRequest) ProtoMessage() {}
+
+func (x *ListDetectionFixturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectionFixturesRequest.ProtoReflect.Descriptor instead.
+func (*ListDetectionFixturesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{10}
+}
+
+type ListDetectionFixturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fixtures      []*DetectionFixture    `protobuf:"bytes,1,rep,name=fixtures,proto3" json:"fixtures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDetectionFixturesResponse) Reset() {
+	*x = ListDetectionFixturesResponse{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectionFixturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectionFixturesResponse) ProtoMessage() {}
+
+func (x *ListDetectionFixturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectionFixturesResponse.ProtoReflect.Descriptor instead.
+func (*ListDetectionFixturesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDetectionFixturesResponse) GetFixtures() []*DetectionFixture {
+	if x != nil {
+		return x.Fixtures
+	}
+	return nil
+}
+
+type DeleteDetectionFixtureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDetectionFixtureRequest) Reset() {
+	*x = DeleteDetectionFixtureRequest{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDetectionFixtureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDetectionFixtureRequest) ProtoMessage() {}
+
+func (x *DeleteDetectionFixtureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDetectionFixtureRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDetectionFixtureRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteDetectionFixtureRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EvaluateDetectionFixturesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Names might be set to only evaluate the given fixtures.
+	Names         []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateDetectionFixturesRequest) Reset() {
+	*x = EvaluateDetectionFixturesRequest{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateDetectionFixturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateDetectionFixturesRequest) ProtoMessage() {}
+
+func (x *EvaluateDetectionFixturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateDetectionFixturesRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateDetectionFixturesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EvaluateDetectionFixturesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type EvaluateDetectionFixturesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*DetectionFixtureResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateDetectionFixturesResponse) Reset() {
+	*x = EvaluateDetectionFixturesResponse{}
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateDetectionFixturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateDetectionFixturesResponse) ProtoMessage() {}
+
+func (x *EvaluateDetectionFixturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_detection_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateDetectionFixturesResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateDetectionFixturesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EvaluateDetectionFixturesResponse) GetResults() []*DetectionFixtureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_tkd_treatment_v1alpha_detection_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_detection_proto_rawDesc = "" +
+	"\n" +
+	"%tkd/treatment/v1alpha/detection.proto\x12\x15tkd.treatment.v1alpha\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1etkd/common/v1/descriptor.proto\x1a tkd/treatment/v1/treatment.proto\"\xb5\x01\n" +
+	"\x10MatchExplanation\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"match_word\x18\x02 \x01(\tR\tmatchWord\x12:\n" +
+	"\x06source\x18\x03 \x01(\x0e2\".tkd.treatment.v1alpha.MatchSourceR\x06source\x12\x14\n" +
+	"\x05exact\x18\x04 \x01(\bR\x05exact\x12\x1a\n" +
+	"\bdistance\x18\x05 \x01(\x05R\bdistance\"\xe2\x01\n" +
+	"\x0eTreatmentMatch\x129\n" +
+	"\ttreatment\x18\x01 \x01(\v2\x1b.tkd.treatment.v1.TreatmentR\ttreatment\x12#\n" +
+	"\rexact_matches\x18\x02 \x01(\x05R\fexactMatches\x12#\n" +
+	"\rfuzzy_matches\x18\x03 \x01(\x05R\ffuzzyMatches\x12K\n" +
+	"\fexplanations\x18\x04 \x03(\v2'.tkd.treatment.v1alpha.MatchExplanationR\fexplanations\"\x92\x01\n" +
+	"\x17DetectTreatmentsRequest\x12 \n" +
+	"\x06values\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\x06values\x12\x18\n" +
+	"\aspecies\x18\x02 \x03(\tR\aspecies\x12%\n" +
+	"\x0edetect_species\x18\x03 \x01(\bR\rdetectSpecies\x12\x14\n" +
+	"\x05fuzzy\x18\x04 \x01(\bR\x05fuzzy\"u\n" +
+	"\x18DetectTreatmentsResponse\x12?\n" +
+	"\amatches\x18\x01 \x03(\v2%.tkd.treatment.v1alpha.TreatmentMatchR\amatches\x12\x18\n" +
+	"\aspecies\x18\x02 \x03(\tR\aspecies\"\xeb\x02\n" +
+	"\x0eMatchRuleIssue\x12=\n" +
+	"\x04kind\x18\x01 \x01(\x0e2).tkd.treatment.v1alpha.MatchRuleIssueKindR\x04kind\x12B\n" +
+	"\ventity_kind\x18\x02 \x01(\x0e2!.tkd.treatment.v1alpha.EntityKindR\n" +
+	"entityKind\x12\x16\n" +
+	"\x06entity\x18\x03 \x01(\tR\x06entity\x12\x1d\n" +
+	"\n" +
+	"match_word\x18\x04 \x01(\tR\tmatchWord\x12-\n" +
+	"\x12conflicting_entity\x18\x05 \x01(\tR\x11conflictingEntity\x124\n" +
+	"\x16conflicting_match_word\x18\x06 \x01(\tR\x14conflictingMatchWord\x12\x18\n" +
+	"\aspecies\x18\a \x01(\tR\aspecies\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\"\x1a\n" +
+	"\x18AnalyzeMatchRulesRequest\"Z\n" +
+	"\x19AnalyzeMatchRulesResponse\x12=\n" +
+	"\x06issues\x18\x01 \x03(\v2%.tkd.treatment.v1alpha.MatchRuleIssueR\x06issues\"\xce\x01\n" +
+	"\x10DetectionFixture\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\x06values\x18\x03 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\x06values\x12)\n" +
+	"\x10expected_species\x18\x04 \x03(\tR\x0fexpectedSpecies\x12/\n" +
+	"\x13expected_treatments\x18\x05 \x03(\tR\x12expectedTreatments\"\xbc\x01\n" +
+	"\x16DetectionFixtureResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12)\n" +
+	"\x10detected_species\x18\x03 \x03(\tR\x0fdetectedSpecies\x12/\n" +
+	"\x13detected_treatments\x18\x04 \x03(\tR\x12detectedTreatments\x12\x1a\n" +
+	"\bfailures\x18\x05 \x03(\tR\bfailures\"n\n" +
+	"\x1bDetectionFixtureRegressions\x12O\n" +
+	"\vregressions\x18\x01 \x03(\v2-.tkd.treatment.v1alpha.DetectionFixtureResultR\vregressions\"\x1e\n" +
+	"\x1cListDetectionFixturesRequest\"d\n" +
+	"\x1dListDetectionFixturesResponse\x12C\n" +
+	"\bfixtures\x18\x01 \x03(\v2'.tkd.treatment.v1alpha.DetectionFixtureR\bfixtures\";\n" +
+	"\x1dDeleteDetectionFixtureRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"8\n" +
+	" EvaluateDetectionFixturesRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"l\n" +
+	"!EvaluateDetectionFixturesResponse\x12G\n" +
+	"\aresults\x18\x01 \x03(\v2-.tkd.treatment.v1alpha.DetectionFixtureResultR\aresults*m\n" +
+	"\vMatchSource\x12\x1c\n" +
+	"\x18MATCH_SOURCE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dMATCH_SOURCE_MATCH_EVENT_TEXT\x10\x01\x12\x1d\n" +
+	"\x19MATCH_SOURCE_DISPLAY_NAME\x10\x02*\xfe\x01\n" +
+	"\x12MatchRuleIssueKind\x12%\n" +
+	"!MATCH_RULE_ISSUE_KIND_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fMATCH_RULE_ISSUE_KIND_DUPLICATE\x10\x01\x12\"\n" +
+	"\x1eMATCH_RULE_ISSUE_KIND_SUBSUMED\x10\x02\x12\"\n" +
+	"\x1eMATCH_RULE_ISSUE_KIND_SHADOWED\x10\x03\x12(\n" +
+	"$MATCH_RULE_ISSUE_KIND_NO_MATCH_WORDS\x10\x04\x12*\n" +
+	"&MATCH_RULE_ISSUE_KIND_BLANK_MATCH_WORD\x10\x05*t\n" +
+	"\n" +
+	"EntityKind\x12\x1b\n" +
+	"\x17ENTITY_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ENTITY_KIND_SPECIES\x10\x01\x12\x19\n" +
+	"\x15ENTITY_KIND_TREATMENT\x10\x02\x12\x15\n" +
+	"\x11ENTITY_KIND_BREED\x10\x032\x93\x06\n" +
+	"\x10DetectionService\x12z\n" +
+	"\x10DetectTreatments\x12..tkd.treatment.v1alpha.DetectTreatmentsRequest\x1a/.tkd.treatment.v1alpha.DetectTreatmentsResponse\"\x05\xb2~\x02\b\x01\x12}\n" +
+	"\x11AnalyzeMatchRules\x12/.tkd.treatment.v1alpha.AnalyzeMatchRulesRequest\x1a0.tkd.treatment.v1alpha.AnalyzeMatchRulesResponse\"\x05\xb2~\x02\b\x01\x12q\n" +
+	"\x16CreateDetectionFixture\x12'.tkd.treatment.v1alpha.DetectionFixture\x1a'.tkd.treatment.v1alpha.DetectionFixture\"\x05\xb2~\x02\b\x01\x12\x89\x01\n" +
+	"\x15ListDetectionFixtures\x123.tkd.treatment.v1alpha.ListDetectionFixturesRequest\x1a4.tkd.treatment.v1alpha.ListDetectionFixturesResponse\"\x05\xb2~\x02\b\x01\x12m\n" +
+	"\x16DeleteDetectionFixture\x124.tkd.treatment.v1alpha.DeleteDetectionFixtureRequest\x1a\x16.google.protobuf.Empty\"\x05\xb2~\x02\b\x01\x12\x95\x01\n" +
+	"\x19EvaluateDetectionFixtures\x127.tkd.treatment.v1alpha.EvaluateDetectionFixturesRequest\x1a8.tkd.treatment.v1alpha.EvaluateDetectionFixturesResponse\"\x05\xb2~\x02\b\x01BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_detection_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_detection_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_detection_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_detection_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_detection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_detection_proto_rawDesc), len(file_tkd_treatment_v1alpha_detection_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_detection_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_detection_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_tkd_treatment_v1alpha_detection_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_tkd_treatment_v1alpha_detection_proto_goTypes = []any{
+	(MatchSource)(0),                          // 0: tkd.treatment.v1alpha.MatchSource
+	(MatchRuleIssueKind)(0),                   // 1: tkd.treatment.v1alpha.MatchRuleIssueKind
+	(EntityKind)(0),                           // 2: tkd.treatment.v1alpha.EntityKind
+	(*MatchExplanation)(nil),                  // 3: tkd.treatment.v1alpha.MatchExplanation
+	(*TreatmentMatch)(nil),                    // 4: tkd.treatment.v1alpha.TreatmentMatch
+	(*DetectTreatmentsRequest)(nil),           // 5: tkd.treatment.v1alpha.DetectTreatmentsRequest
+	(*DetectTreatmentsResponse)(nil),          // 6: tkd.treatment.v1alpha.DetectTreatmentsResponse
+	(*MatchRuleIssue)(nil),                    // 7: tkd.treatment.v1alpha.MatchRuleIssue
+	(*AnalyzeMatchRulesRequest)(nil),          // 8: tkd.treatment.v1alpha.AnalyzeMatchRulesRequest
+	(*AnalyzeMatchRulesResponse)(nil),         // 9: tkd.treatment.v1alpha.AnalyzeMatchRulesResponse
+	(*DetectionFixture)(nil),                  // 10: tkd.treatment.v1alpha.DetectionFixture
+	(*DetectionFixtureResult)(nil),            // 11: tkd.treatment.v1alpha.DetectionFixtureResult
+	(*DetectionFixtureRegressions)(nil),       // 12: tkd.treatment.v1alpha.DetectionFixtureRegressions
+	(*ListDetectionFixturesRequest)(nil),      // 13: tkd.treatment.v1alpha.ListDetectionFixturesRequest
+	(*ListDetectionFixturesResponse)(nil),     // 14: tkd.treatment.v1alpha.ListDetectionFixturesResponse
+	(*DeleteDetectionFixtureRequest)(nil),     // 15: tkd.treatment.v1alpha.DeleteDetectionFixtureRequest
+	(*EvaluateDetectionFixturesRequest)(nil),  // 16: tkd.treatment.v1alpha.EvaluateDetectionFixturesRequest
+	(*EvaluateDetectionFixturesResponse)(nil), // 17: tkd.treatment.v1alpha.EvaluateDetectionFixturesResponse
+	(*v1.Treatment)(nil),                      // 18: tkd.treatment.v1.Treatment
+	(*emptypb.Empty)(nil),                     // 19: google.protobuf.Empty
+}
+var file_tkd_treatment_v1alpha_detection_proto_depIdxs = []int32{
+	0,  // 0: tkd.treatment.v1alpha.MatchExplanation.source:type_name -> tkd.treatment.v1alpha.MatchSource
+	18, // 1: tkd.treatment.v1alpha.TreatmentMatch.treatment:type_name -> tkd.treatment.v1.Treatment
+	3,  // 2: tkd.treatment.v1alpha.TreatmentMatch.explanations:type_name -> tkd.treatment.v1alpha.MatchExplanation
+	4,  // 3: tkd.treatment.v1alpha.DetectTreatmentsResponse.matches:type_name -> tkd.treatment.v1alpha.TreatmentMatch
+	1,  // 4: tkd.treatment.v1alpha.MatchRuleIssue.kind:type_name -> tkd.treatment.v1alpha.MatchRuleIssueKind
+	2,  // 5: tkd.treatment.v1alpha.MatchRuleIssue.entity_kind:type_name -> tkd.treatment.v1alpha.EntityKind
+	7,  // 6: tkd.treatment.v1alpha.AnalyzeMatchRulesResponse.issues:type_name -> tkd.treatment.v1alpha.MatchRuleIssue
+	11, // 7: tkd.treatment.v1alpha.DetectionFixtureRegressions.regressions:type_name -> tkd.treatment.v1alpha.DetectionFixtureResult
+	10, // 8: tkd.treatment.v1alpha.ListDetectionFixturesResponse.fixtures:type_name -> tkd.treatment.v1alpha.DetectionFixture
+	11, // 9: tkd.treatment.v1alpha.EvaluateDetectionFixturesResponse.results:type_name -> tkd.treatment.v1alpha.DetectionFixtureResult
+	5,  // 10: tkd.treatment.v1alpha.DetectionService.DetectTreatments:input_type -> tkd.treatment.v1alpha.DetectTreatmentsRequest
+	8,  // 11: tkd.treatment.v1alpha.DetectionService.AnalyzeMatchRules:input_type -> tkd.treatment.v1alpha.AnalyzeMatchRulesRequest
+	10, // 12: tkd.treatment.v1alpha.DetectionService.CreateDetectionFixture:input_type -> tkd.treatment.v1alpha.DetectionFixture
+	13, // 13: tkd.treatment.v1alpha.DetectionService.ListDetectionFixtures:input_type -> tkd.treatment.v1alpha.ListDetectionFixturesRequest
+	15, // 14: tkd.treatment.v1alpha.DetectionService.DeleteDetectionFixture:input_type -> tkd.treatment.v1alpha.DeleteDetectionFixtureRequest
+	16, // 15: tkd.treatment.v1alpha.DetectionService.EvaluateDetectionFixtures:input_type -> tkd.treatment.v1alpha.EvaluateDetectionFixturesRequest
+	6,  // 16: tkd.treatment.v1alpha.DetectionService.DetectTreatments:output_type -> tkd.treatment.v1alpha.DetectTreatmentsResponse
+	9,  // 17: tkd.treatment.v1alpha.DetectionService.AnalyzeMatchRules:output_type -> tkd.treatment.v1alpha.AnalyzeMatchRulesResponse
+	10, // 18: tkd.treatment.v1alpha.DetectionService.CreateDetectionFixture:output_type -> tkd.treatment.v1alpha.DetectionFixture
+	14, // 19: tkd.treatment.v1alpha.DetectionService.ListDetectionFixtures:output_type -> tkd.treatment.v1alpha.ListDetectionFixturesResponse
+	19, // 20: tkd.treatment.v1alpha.DetectionService.DeleteDetectionFixture:output_type -> google.protobuf.Empty
+	17, // 21: tkd.treatment.v1alpha.DetectionService.EvaluateDetectionFixtures:output_type -> tkd.treatment.v1alpha.EvaluateDetectionFixturesResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_detection_proto_init() }
+func file_tkd_treatment_v1alpha_detection_proto_init() {
+	if File_tkd_treatment_v1alpha_detection_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_detection_proto_rawDesc), len(file_tkd_treatment_v1alpha_detection_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_detection_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_detection_proto_depIdxs,
+		EnumInfos:         file_tkd_treatment_v1alpha_detection_proto_enumTypes,
+		MessageInfos:      file_tkd_treatment_v1alpha_detection_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_detection_proto = out.File
+	file_tkd_treatment_v1alpha_detection_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_detection_proto_depIdxs = nil
+}
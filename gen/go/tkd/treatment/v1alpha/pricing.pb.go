@@ -0,0 +1,488 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/pricing.proto
+
+package treatmentv1alpha
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Price is an entry of the effective-dated price list of a treatment.
+// All amounts are specified in cents.
+type Price struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID of the price entry and assigned by the service.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Treatment is the name of the treatment.
+	Treatment string `protobuf:"bytes,2,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	// Species might be set if the price only applies to the given species.
+	// Species specific prices take precedence over prices without species.
+	Species string `protobuf:"bytes,3,opt,name=species,proto3" json:"species,omitempty"`
+	// ValidFrom is the time from which on the price is valid. The price
+	// is valid until another price for the same treatment and species
+	// becomes valid.
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// MinNet is the lower bound of the net price.
+	MinNet int64 `protobuf:"varint,5,opt,name=min_net,json=minNet,proto3" json:"min_net,omitempty"`
+	// MaxNet is the upper bound of the net price. If zero, the price is
+	// fixed at min_net.
+	MaxNet int64 `protobuf:"varint,6,opt,name=max_net,json=maxNet,proto3" json:"max_net,omitempty"`
+	// VatRate is the VAT rate in percent, for example, 20 or 13.
+	VatRate float64 `protobuf:"fixed64,7,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate,omitempty"`
+	// MinGross is the lower bound of the gross price. It is calculated
+	// by the service.
+	MinGross int64 `protobuf:"varint,8,opt,name=min_gross,json=minGross,proto3" json:"min_gross,omitempty"`
+	// MaxGross is the upper bound of the gross price. It is calculated
+	// by the service.
+	MaxGross int64 `protobuf:"varint,9,opt,name=max_gross,json=maxGross,proto3" json:"max_gross,omitempty"`
+	// FeeScheduleCode optionally holds the code of the price in the
+	// official fee schedule.
+	FeeScheduleCode string `protobuf:"bytes,10,opt,name=fee_schedule_code,json=feeScheduleCode,proto3" json:"fee_schedule_code,omitempty"`
+	// Currency is the ISO 4217 currency code. Defaults to EUR.
+	Currency string `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Published must be set to true for the price to be included in the
+	// public self-booking catalog.
+	Published     bool `protobuf:"varint,12,opt,name=published,proto3" json:"published,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Price) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Price) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *Price) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *Price) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Price) GetMinNet() int64 {
+	if x != nil {
+		return x.MinNet
+	}
+	return 0
+}
+
+func (x *Price) GetMaxNet() int64 {
+	if x != nil {
+		return x.MaxNet
+	}
+	return 0
+}
+
+func (x *Price) GetVatRate() float64 {
+	if x != nil {
+		return x.VatRate
+	}
+	return 0
+}
+
+func (x *Price) GetMinGross() int64 {
+	if x != nil {
+		return x.MinGross
+	}
+	return 0
+}
+
+func (x *Price) GetMaxGross() int64 {
+	if x != nil {
+		return x.MaxGross
+	}
+	return 0
+}
+
+func (x *Price) GetFeeScheduleCode() string {
+	if x != nil {
+		return x.FeeScheduleCode
+	}
+	return ""
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Price) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+type ListPricesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Treatment might be set to only return prices for the given treatment.
+	Treatment string `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	// Species might be set to only return prices for the given species.
+	Species       string `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPricesRequest) Reset() {
+	*x = ListPricesRequest{}
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPricesRequest) ProtoMessage() {}
+
+func (x *ListPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPricesRequest.ProtoReflect.Descriptor instead.
+func (*ListPricesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPricesRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *ListPricesRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+type ListPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prices        []*Price               `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPricesResponse) Reset() {
+	*x = ListPricesResponse{}
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPricesResponse) ProtoMessage() {}
+
+func (x *ListPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPricesResponse.ProtoReflect.Descriptor instead.
+func (*ListPricesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_pricing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPricesResponse) GetPrices() []*Price {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type DeletePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceRequest) Reset() {
+	*x = DeletePriceRequest{}
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRequest) ProtoMessage() {}
+
+func (x *DeletePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_pricing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeletePriceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetEffectivePriceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Treatment string                 `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	// Species might be set to prefer prices for the given species.
+	Species string `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	// Time is the time for which the price should be returned. Defaults
+	// to now.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectivePriceRequest) Reset() {
+	*x = GetEffectivePriceRequest{}
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePriceRequest) ProtoMessage() {}
+
+func (x *GetEffectivePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_pricing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePriceRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectivePriceRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_pricing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetEffectivePriceRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *GetEffectivePriceRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *GetEffectivePriceRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_tkd_treatment_v1alpha_pricing_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_pricing_proto_rawDesc = "" +
+	"\n" +
+	"#tkd/treatment/v1alpha/pricing.proto\x12\x15tkd.treatment.v1alpha\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1etkd/common/v1/descriptor.proto\"\xb2\x03\n" +
+	"\x05Price\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\ttreatment\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\ttreatment\x12\x18\n" +
+	"\aspecies\x18\x03 \x01(\tR\aspecies\x12A\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tvalidFrom\x12 \n" +
+	"\amin_net\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06minNet\x12 \n" +
+	"\amax_net\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06maxNet\x122\n" +
+	"\bvat_rate\x18\a \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\avatRate\x12\x1b\n" +
+	"\tmin_gross\x18\b \x01(\x03R\bminGross\x12\x1b\n" +
+	"\tmax_gross\x18\t \x01(\x03R\bmaxGross\x12*\n" +
+	"\x11fee_schedule_code\x18\n" +
+	" \x01(\tR\x0ffeeScheduleCode\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tpublished\x18\f \x01(\bR\tpublished\"K\n" +
+	"\x11ListPricesRequest\x12\x1c\n" +
+	"\ttreatment\x18\x01 \x01(\tR\ttreatment\x12\x18\n" +
+	"\aspecies\x18\x02 \x01(\tR\aspecies\"J\n" +
+	"\x12ListPricesResponse\x124\n" +
+	"\x06prices\x18\x01 \x03(\v2\x1c.tkd.treatment.v1alpha.PriceR\x06prices\",\n" +
+	"\x12DeletePriceRequest\x12\x16\n" +
+	"\x02id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\"\x8a\x01\n" +
+	"\x18GetEffectivePriceRequest\x12$\n" +
+	"\ttreatment\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\ttreatment\x12\x18\n" +
+	"\aspecies\x18\x02 \x01(\tR\aspecies\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time2\x90\x03\n" +
+	"\x0ePricingService\x12P\n" +
+	"\vCreatePrice\x12\x1c.tkd.treatment.v1alpha.Price\x1a\x1c.tkd.treatment.v1alpha.Price\"\x05\xb2~\x02\b\x01\x12h\n" +
+	"\n" +
+	"ListPrices\x12(.tkd.treatment.v1alpha.ListPricesRequest\x1a).tkd.treatment.v1alpha.ListPricesResponse\"\x05\xb2~\x02\b\x01\x12W\n" +
+	"\vDeletePrice\x12).tkd.treatment.v1alpha.DeletePriceRequest\x1a\x16.google.protobuf.Empty\"\x05\xb2~\x02\b\x01\x12i\n" +
+	"\x11GetEffectivePrice\x12/.tkd.treatment.v1alpha.GetEffectivePriceRequest\x1a\x1c.tkd.treatment.v1alpha.Price\"\x05\xb2~\x02\b\x01BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_pricing_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_pricing_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_pricing_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_pricing_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_pricing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_pricing_proto_rawDesc), len(file_tkd_treatment_v1alpha_pricing_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_pricing_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_pricing_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_tkd_treatment_v1alpha_pricing_proto_goTypes = []any{
+	(*Price)(nil),                    // 0: tkd.treatment.v1alpha.Price
+	(*ListPricesRequest)(nil),        // 1: tkd.treatment.v1alpha.ListPricesRequest
+	(*ListPricesResponse)(nil),       // 2: tkd.treatment.v1alpha.ListPricesResponse
+	(*DeletePriceRequest)(nil),       // 3: tkd.treatment.v1alpha.DeletePriceRequest
+	(*GetEffectivePriceRequest)(nil), // 4: tkd.treatment.v1alpha.GetEffectivePriceRequest
+	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 6: google.protobuf.Empty
+}
+var file_tkd_treatment_v1alpha_pricing_proto_depIdxs = []int32{
+	5, // 0: tkd.treatment.v1alpha.Price.valid_from:type_name -> google.protobuf.Timestamp
+	0, // 1: tkd.treatment.v1alpha.ListPricesResponse.prices:type_name -> tkd.treatment.v1alpha.Price
+	5, // 2: tkd.treatment.v1alpha.GetEffectivePriceRequest.time:type_name -> google.protobuf.Timestamp
+	0, // 3: tkd.treatment.v1alpha.PricingService.CreatePrice:input_type -> tkd.treatment.v1alpha.Price
+	1, // 4: tkd.treatment.v1alpha.PricingService.ListPrices:input_type -> tkd.treatment.v1alpha.ListPricesRequest
+	3, // 5: tkd.treatment.v1alpha.PricingService.DeletePrice:input_type -> tkd.treatment.v1alpha.DeletePriceRequest
+	4, // 6: tkd.treatment.v1alpha.PricingService.GetEffectivePrice:input_type -> tkd.treatment.v1alpha.GetEffectivePriceRequest
+	0, // 7: tkd.treatment.v1alpha.PricingService.CreatePrice:output_type -> tkd.treatment.v1alpha.Price
+	2, // 8: tkd.treatment.v1alpha.PricingService.ListPrices:output_type -> tkd.treatment.v1alpha.ListPricesResponse
+	6, // 9: tkd.treatment.v1alpha.PricingService.DeletePrice:output_type -> google.protobuf.Empty
+	0, // 10: tkd.treatment.v1alpha.PricingService.GetEffectivePrice:output_type -> tkd.treatment.v1alpha.Price
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_pricing_proto_init() }
+func file_tkd_treatment_v1alpha_pricing_proto_init() {
+	if File_tkd_treatment_v1alpha_pricing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_pricing_proto_rawDesc), len(file_tkd_treatment_v1alpha_pricing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_pricing_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_pricing_proto_depIdxs,
+		MessageInfos:      file_tkd_treatment_v1alpha_pricing_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_pricing_proto = out.File
+	file_tkd_treatment_v1alpha_pricing_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_pricing_proto_depIdxs = nil
+}
@@ -0,0 +1,1264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/species.proto
+
+package treatmentv1alpha
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MergeIcon selects the icon of the merged species.
+type MergeIcon int32
+
+const (
+	// Keep the icon of the target species. If the target species does not
+	// have an icon, the icon of the source species is used.
+	MergeIcon_MERGE_ICON_UNSPECIFIED MergeIcon = 0
+	// Always keep the icon of the target species.
+	MergeIcon_MERGE_ICON_TARGET MergeIcon = 1
+	// Use the icon of the source species.
+	MergeIcon_MERGE_ICON_SOURCE MergeIcon = 2
+)
+
+// Enum value maps for MergeIcon.
+var (
+	MergeIcon_name = map[int32]string{
+		0: "MERGE_ICON_UNSPECIFIED",
+		1: "MERGE_ICON_TARGET",
+		2: "MERGE_ICON_SOURCE",
+	}
+	MergeIcon_value = map[string]int32{
+		"MERGE_ICON_UNSPECIFIED": 0,
+		"MERGE_ICON_TARGET":      1,
+		"MERGE_ICON_SOURCE":      2,
+	}
+)
+
+func (x MergeIcon) Enum() *MergeIcon {
+	p := new(MergeIcon)
+	*p = x
+	return p
+}
+
+func (x MergeIcon) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeIcon) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_species_proto_enumTypes[0].Descriptor()
+}
+
+func (MergeIcon) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_species_proto_enumTypes[0]
+}
+
+func (x MergeIcon) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeIcon.Descriptor instead.
+func (MergeIcon) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{0}
+}
+
+// DeletePolicy defines how references to a deleted species are handled.
+type DeletePolicy int32
+
+const (
+	// Defaults to DELETE_POLICY_RESTRICT.
+	DeletePolicy_DELETE_POLICY_UNSPECIFIED DeletePolicy = 0
+	// Reject the deletion if the species is referenced by any treatment,
+	// breed or price.
+	DeletePolicy_DELETE_POLICY_RESTRICT DeletePolicy = 1
+	// Remove the species from all treatments. Treatments that do not have
+	// any species left are kept and apply to all species afterwards.
+	DeletePolicy_DELETE_POLICY_DETACH DeletePolicy = 2
+	// Delete treatments that do not have any species left and remove the
+	// species from all others. This is the behaviour of
+	// tkd.treatment.v1.SpeciesService.DeleteSpecies.
+	DeletePolicy_DELETE_POLICY_CASCADE DeletePolicy = 3
+)
+
+// Enum value maps for DeletePolicy.
+var (
+	DeletePolicy_name = map[int32]string{
+		0: "DELETE_POLICY_UNSPECIFIED",
+		1: "DELETE_POLICY_RESTRICT",
+		2: "DELETE_POLICY_DETACH",
+		3: "DELETE_POLICY_CASCADE",
+	}
+	DeletePolicy_value = map[string]int32{
+		"DELETE_POLICY_UNSPECIFIED": 0,
+		"DELETE_POLICY_RESTRICT":    1,
+		"DELETE_POLICY_DETACH":      2,
+		"DELETE_POLICY_CASCADE":     3,
+	}
+)
+
+func (x DeletePolicy) Enum() *DeletePolicy {
+	p := new(DeletePolicy)
+	*p = x
+	return p
+}
+
+func (x DeletePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeletePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_species_proto_enumTypes[1].Descriptor()
+}
+
+func (DeletePolicy) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_species_proto_enumTypes[1]
+}
+
+func (x DeletePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeletePolicy.Descriptor instead.
+func (DeletePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{1}
+}
+
+// SpeciesNode describes the position of a species in the species hierarchy.
+type SpeciesNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the name of the species.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Parent is the name of the parent species (group). Empty for
+	// top-level species.
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Children holds the names of all direct children of the species.
+	Children []string `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	// Ancestors holds the names of all ancestors of the species, the
+	// direct parent first.
+	Ancestors     []string `protobuf:"bytes,4,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeciesNode) Reset() {
+	*x = SpeciesNode{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeciesNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeciesNode) ProtoMessage() {}
+
+func (x *SpeciesNode) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeciesNode.ProtoReflect.Descriptor instead.
+func (*SpeciesNode) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SpeciesNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpeciesNode) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *SpeciesNode) GetChildren() []string {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *SpeciesNode) GetAncestors() []string {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+type SetSpeciesParentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the name of the species.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Parent is the name of the new parent species. An empty parent
+	// turns the species into a top-level species.
+	Parent        string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpeciesParentRequest) Reset() {
+	*x = SetSpeciesParentRequest{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpeciesParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpeciesParentRequest) ProtoMessage() {}
+
+func (x *SetSpeciesParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpeciesParentRequest.ProtoReflect.Descriptor instead.
+func (*SetSpeciesParentRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetSpeciesParentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetSpeciesParentRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type GetSpeciesHierarchyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpeciesHierarchyRequest) Reset() {
+	*x = GetSpeciesHierarchyRequest{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpeciesHierarchyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpeciesHierarchyRequest) ProtoMessage() {}
+
+func (x *GetSpeciesHierarchyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpeciesHierarchyRequest.ProtoReflect.Descriptor instead.
+func (*GetSpeciesHierarchyRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{2}
+}
+
+type GetSpeciesHierarchyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Species       []*SpeciesNode         `protobuf:"bytes,1,rep,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpeciesHierarchyResponse) Reset() {
+	*x = GetSpeciesHierarchyResponse{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpeciesHierarchyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpeciesHierarchyResponse) ProtoMessage() {}
+
+func (x *GetSpeciesHierarchyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpeciesHierarchyResponse.ProtoReflect.Descriptor instead.
+func (*GetSpeciesHierarchyResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSpeciesHierarchyResponse) GetSpecies() []*SpeciesNode {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+type MergeSpeciesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Source is the name of the species that is merged into target and
+	// deleted afterwards.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Target is the name of the species that remains.
+	Target string    `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Icon   MergeIcon `protobuf:"varint,3,opt,name=icon,proto3,enum=tkd.treatment.v1alpha.MergeIcon" json:"icon,omitempty"`
+	// DryRun may be set to true to only report the affected entities
+	// without changing anything.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeSpeciesRequest) Reset() {
+	*x = MergeSpeciesRequest{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeSpeciesRequest) ProtoMessage() {}
+
+func (x *MergeSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*MergeSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MergeSpeciesRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MergeSpeciesRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *MergeSpeciesRequest) GetIcon() MergeIcon {
+	if x != nil {
+		return x.Icon
+	}
+	return MergeIcon_MERGE_ICON_UNSPECIFIED
+}
+
+func (x *MergeSpeciesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MergeSpeciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Species is the (resulting) target species.
+	Species *v1.Species `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	// AffectedTreatments holds the names of all treatments that referenced
+	// the source species.
+	AffectedTreatments []string `protobuf:"bytes,2,rep,name=affected_treatments,json=affectedTreatments,proto3" json:"affected_treatments,omitempty"`
+	// AffectedBreeds holds the names of all breeds of the source species.
+	AffectedBreeds []string `protobuf:"bytes,3,rep,name=affected_breeds,json=affectedBreeds,proto3" json:"affected_breeds,omitempty"`
+	// AffectedSpecies holds the names of all child species of the source
+	// species.
+	AffectedSpecies []string `protobuf:"bytes,4,rep,name=affected_species,json=affectedSpecies,proto3" json:"affected_species,omitempty"`
+	// AffectedFixtures holds the names of all detection fixtures that
+	// expect the source species.
+	AffectedFixtures []string `protobuf:"bytes,5,rep,name=affected_fixtures,json=affectedFixtures,proto3" json:"affected_fixtures,omitempty"`
+	// PriceConflicts holds all prices of the source species that cannot be
+	// moved to the target species since it already has a price for the same
+	// treatment and valid-from time. A merge with conflicts is rejected.
+	PriceConflicts []*PriceConflict `protobuf:"bytes,6,rep,name=price_conflicts,json=priceConflicts,proto3" json:"price_conflicts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MergeSpeciesResponse) Reset() {
+	*x = MergeSpeciesResponse{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeSpeciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeSpeciesResponse) ProtoMessage() {}
+
+func (x *MergeSpeciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeSpeciesResponse.ProtoReflect.Descriptor instead.
+func (*MergeSpeciesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MergeSpeciesResponse) GetSpecies() *v1.Species {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *MergeSpeciesResponse) GetAffectedTreatments() []string {
+	if x != nil {
+		return x.AffectedTreatments
+	}
+	return nil
+}
+
+func (x *MergeSpeciesResponse) GetAffectedBreeds() []string {
+	if x != nil {
+		return x.AffectedBreeds
+	}
+	return nil
+}
+
+func (x *MergeSpeciesResponse) GetAffectedSpecies() []string {
+	if x != nil {
+		return x.AffectedSpecies
+	}
+	return nil
+}
+
+func (x *MergeSpeciesResponse) GetAffectedFixtures() []string {
+	if x != nil {
+		return x.AffectedFixtures
+	}
+	return nil
+}
+
+func (x *MergeSpeciesResponse) GetPriceConflicts() []*PriceConflict {
+	if x != nil {
+		return x.PriceConflicts
+	}
+	return nil
+}
+
+// PriceConflict describes a price of the source species of a merge that
+// collides with a price of the target species.
+type PriceConflict struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Treatment string                 `protobuf:"bytes,1,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// SourcePrice is the ID of the price of the source species.
+	SourcePrice string `protobuf:"bytes,3,opt,name=source_price,json=sourcePrice,proto3" json:"source_price,omitempty"`
+	// TargetPrice is the ID of the price of the target species.
+	TargetPrice   string `protobuf:"bytes,4,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceConflict) Reset() {
+	*x = PriceConflict{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceConflict) ProtoMessage() {}
+
+func (x *PriceConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceConflict.ProtoReflect.Descriptor instead.
+func (*PriceConflict) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceConflict) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *PriceConflict) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *PriceConflict) GetSourcePrice() string {
+	if x != nil {
+		return x.SourcePrice
+	}
+	return ""
+}
+
+func (x *PriceConflict) GetTargetPrice() string {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return ""
+}
+
+type RenameSpeciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameSpeciesRequest) Reset() {
+	*x = RenameSpeciesRequest{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSpeciesRequest) ProtoMessage() {}
+
+func (x *RenameSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*RenameSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RenameSpeciesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameSpeciesRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// SpeciesRenamedEvent is published when a species has been renamed.
+type SpeciesRenamedEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OldName string                 `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// AliasExpiresAt is the time until the old name is still resolved to
+	// the new one.
+	AliasExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=alias_expires_at,json=aliasExpiresAt,proto3" json:"alias_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SpeciesRenamedEvent) Reset() {
+	*x = SpeciesRenamedEvent{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeciesRenamedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeciesRenamedEvent) ProtoMessage() {}
+
+func (x *SpeciesRenamedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeciesRenamedEvent.ProtoReflect.Descriptor instead.
+func (*SpeciesRenamedEvent) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SpeciesRenamedEvent) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *SpeciesRenamedEvent) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *SpeciesRenamedEvent) GetAliasExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AliasExpiresAt
+	}
+	return nil
+}
+
+// DeleteSpeciesImpact describes the changes caused by deleting a species.
+type DeleteSpeciesImpact struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Species string                 `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	Policy  DeletePolicy           `protobuf:"varint,2,opt,name=policy,proto3,enum=tkd.treatment.v1alpha.DeletePolicy" json:"policy,omitempty"`
+	// DeletedTreatments holds the names of all treatments that are deleted.
+	DeletedTreatments []string `protobuf:"bytes,3,rep,name=deleted_treatments,json=deletedTreatments,proto3" json:"deleted_treatments,omitempty"`
+	// ModifiedTreatments holds the names of all treatments that are updated
+	// because they reference the species or a deleted treatment.
+	ModifiedTreatments []string `protobuf:"bytes,4,rep,name=modified_treatments,json=modifiedTreatments,proto3" json:"modified_treatments,omitempty"`
+	// DeletedBreeds holds the names of all breeds of the species.
+	DeletedBreeds []string `protobuf:"bytes,5,rep,name=deleted_breeds,json=deletedBreeds,proto3" json:"deleted_breeds,omitempty"`
+	// ModifiedBundles holds the names of all bundles that contain a deleted
+	// treatment and keep at least two treatments.
+	ModifiedBundles []string `protobuf:"bytes,6,rep,name=modified_bundles,json=modifiedBundles,proto3" json:"modified_bundles,omitempty"`
+	// Violations holds the reasons why the species cannot be deleted with
+	// the given policy. If not empty, the deletion is rejected.
+	Violations []string `protobuf:"bytes,7,rep,name=violations,proto3" json:"violations,omitempty"`
+	// DeletedBundles holds the names of all bundles that are deleted because
+	// less than two of their treatments remain.
+	DeletedBundles []string `protobuf:"bytes,8,rep,name=deleted_bundles,json=deletedBundles,proto3" json:"deleted_bundles,omitempty"`
+	// DeletedPrices holds the IDs of all prices that are deleted. These are
+	// the prices of the species and of deleted treatments.
+	DeletedPrices []string `protobuf:"bytes,9,rep,name=deleted_prices,json=deletedPrices,proto3" json:"deleted_prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpeciesImpact) Reset() {
+	*x = DeleteSpeciesImpact{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpeciesImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpeciesImpact) ProtoMessage() {}
+
+func (x *DeleteSpeciesImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpeciesImpact.ProtoReflect.Descriptor instead.
+func (*DeleteSpeciesImpact) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteSpeciesImpact) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *DeleteSpeciesImpact) GetPolicy() DeletePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return DeletePolicy_DELETE_POLICY_UNSPECIFIED
+}
+
+func (x *DeleteSpeciesImpact) GetDeletedTreatments() []string {
+	if x != nil {
+		return x.DeletedTreatments
+	}
+	return nil
+}
+
+func (x *DeleteSpeciesImpact) GetModifiedTreatments() []string {
+	if x != nil {
+		return x.ModifiedTreatments
+	}
+	return nil
+}
+
+func (x *DeleteSpeciesImpact) GetDeletedBreeds() []string {
+	if x != nil {
+		return x.DeletedBreeds
+	}
+	return nil
+}
+
+func (x *DeleteSpeciesImpact) GetModifiedBundles() []string {
+	if x != nil {
+		return x.ModifiedBundles
+	}
+	return nil
+}
+
+func (x *DeleteSpeciesImpact) GetViolations() []string {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *DeleteSpeciesImpact) GetDeletedBundles() []string {
+	if x != nil {
+		return x.DeletedBundles
+	}
+	return nil
+}
+
+func (x *DeleteSpeciesImpact) GetDeletedPrices() []string {
+	if x != nil {
+		return x.DeletedPrices
+	}
+	return nil
+}
+
+type PreviewDeleteSpeciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Policy        DeletePolicy           `protobuf:"varint,2,opt,name=policy,proto3,enum=tkd.treatment.v1alpha.DeletePolicy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDeleteSpeciesRequest) Reset() {
+	*x = PreviewDeleteSpeciesRequest{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDeleteSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDeleteSpeciesRequest) ProtoMessage() {}
+
+func (x *PreviewDeleteSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDeleteSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*PreviewDeleteSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PreviewDeleteSpeciesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreviewDeleteSpeciesRequest) GetPolicy() DeletePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return DeletePolicy_DELETE_POLICY_UNSPECIFIED
+}
+
+type DeleteSpeciesWithPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Policy        DeletePolicy           `protobuf:"varint,2,opt,name=policy,proto3,enum=tkd.treatment.v1alpha.DeletePolicy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpeciesWithPolicyRequest) Reset() {
+	*x = DeleteSpeciesWithPolicyRequest{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpeciesWithPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpeciesWithPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteSpeciesWithPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpeciesWithPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpeciesWithPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteSpeciesWithPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteSpeciesWithPolicyRequest) GetPolicy() DeletePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return DeletePolicy_DELETE_POLICY_UNSPECIFIED
+}
+
+// SpeciesDeletion records the deletion of a species.
+type SpeciesDeletion struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Impact *DeleteSpeciesImpact   `protobuf:"bytes,1,opt,name=impact,proto3" json:"impact,omitempty"`
+	// DeletedBy is the ID of the user that deleted the species, if known.
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeciesDeletion) Reset() {
+	*x = SpeciesDeletion{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeciesDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeciesDeletion) ProtoMessage() {}
+
+func (x *SpeciesDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeciesDeletion.ProtoReflect.Descriptor instead.
+func (*SpeciesDeletion) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SpeciesDeletion) GetImpact() *DeleteSpeciesImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return nil
+}
+
+func (x *SpeciesDeletion) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *SpeciesDeletion) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ListSpeciesDeletionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Species might be set to only return deletions of the given species.
+	Species       string `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpeciesDeletionsRequest) Reset() {
+	*x = ListSpeciesDeletionsRequest{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpeciesDeletionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpeciesDeletionsRequest) ProtoMessage() {}
+
+func (x *ListSpeciesDeletionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpeciesDeletionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSpeciesDeletionsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSpeciesDeletionsRequest) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+type ListSpeciesDeletionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletions     []*SpeciesDeletion     `protobuf:"bytes,1,rep,name=deletions,proto3" json:"deletions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpeciesDeletionsResponse) Reset() {
+	*x = ListSpeciesDeletionsResponse{}
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpeciesDeletionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpeciesDeletionsResponse) ProtoMessage() {}
+
+func (x *ListSpeciesDeletionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_species_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpeciesDeletionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSpeciesDeletionsResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_species_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSpeciesDeletionsResponse) GetDeletions() []*SpeciesDeletion {
+	if x != nil {
+		return x.Deletions
+	}
+	return nil
+}
+
+var File_tkd_treatment_v1alpha_species_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_species_proto_rawDesc = "" +
+	"\n" +
+	"#tkd/treatment/v1alpha/species.proto\x12\x15tkd.treatment.v1alpha\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1etkd/common/v1/descriptor.proto\x1a\x1etkd/treatment/v1/species.proto\"s\n" +
+	"\vSpeciesNode\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\x12\x1a\n" +
+	"\bchildren\x18\x03 \x03(\tR\bchildren\x12\x1c\n" +
+	"\tancestors\x18\x04 \x03(\tR\tancestors\"M\n" +
+	"\x17SetSpeciesParentRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\x16\n" +
+	"\x06parent\x18\x02 \x01(\tR\x06parent\"\x1c\n" +
+	"\x1aGetSpeciesHierarchyRequest\"[\n" +
+	"\x1bGetSpeciesHierarchyResponse\x12<\n" +
+	"\aspecies\x18\x01 \x03(\v2\".tkd.treatment.v1alpha.SpeciesNodeR\aspecies\"\xae\x01\n" +
+	"\x13MergeSpeciesRequest\x12\x1e\n" +
+	"\x06source\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06source\x12\x1e\n" +
+	"\x06target\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06target\x12>\n" +
+	"\x04icon\x18\x03 \x01(\x0e2 .tkd.treatment.v1alpha.MergeIconB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04icon\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xcc\x02\n" +
+	"\x14MergeSpeciesResponse\x123\n" +
+	"\aspecies\x18\x01 \x01(\v2\x19.tkd.treatment.v1.SpeciesR\aspecies\x12/\n" +
+	"\x13affected_treatments\x18\x02 \x03(\tR\x12affectedTreatments\x12'\n" +
+	"\x0faffected_breeds\x18\x03 \x03(\tR\x0eaffectedBreeds\x12)\n" +
+	"\x10affected_species\x18\x04 \x03(\tR\x0faffectedSpecies\x12+\n" +
+	"\x11affected_fixtures\x18\x05 \x03(\tR\x10affectedFixtures\x12M\n" +
+	"\x0fprice_conflicts\x18\x06 \x03(\v2$.tkd.treatment.v1alpha.PriceConflictR\x0epriceConflicts\"\xae\x01\n" +
+	"\rPriceConflict\x12\x1c\n" +
+	"\ttreatment\x18\x01 \x01(\tR\ttreatment\x129\n" +
+	"\n" +
+	"valid_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12!\n" +
+	"\fsource_price\x18\x03 \x01(\tR\vsourcePrice\x12!\n" +
+	"\ftarget_price\x18\x04 \x01(\tR\vtargetPrice\"U\n" +
+	"\x14RenameSpeciesRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12!\n" +
+	"\bnew_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\anewName\"\x91\x01\n" +
+	"\x13SpeciesRenamedEvent\x12\x19\n" +
+	"\bold_name\x18\x01 \x01(\tR\aoldName\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\x12D\n" +
+	"\x10alias_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0ealiasExpiresAt\"\x8e\x03\n" +
+	"\x13DeleteSpeciesImpact\x12\x18\n" +
+	"\aspecies\x18\x01 \x01(\tR\aspecies\x12;\n" +
+	"\x06policy\x18\x02 \x01(\x0e2#.tkd.treatment.v1alpha.DeletePolicyR\x06policy\x12-\n" +
+	"\x12deleted_treatments\x18\x03 \x03(\tR\x11deletedTreatments\x12/\n" +
+	"\x13modified_treatments\x18\x04 \x03(\tR\x12modifiedTreatments\x12%\n" +
+	"\x0edeleted_breeds\x18\x05 \x03(\tR\rdeletedBreeds\x12)\n" +
+	"\x10modified_bundles\x18\x06 \x03(\tR\x0fmodifiedBundles\x12\x1e\n" +
+	"\n" +
+	"violations\x18\a \x03(\tR\n" +
+	"violations\x12'\n" +
+	"\x0fdeleted_bundles\x18\b \x03(\tR\x0edeletedBundles\x12%\n" +
+	"\x0edeleted_prices\x18\t \x03(\tR\rdeletedPrices\"\x80\x01\n" +
+	"\x1bPreviewDeleteSpeciesRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12E\n" +
+	"\x06policy\x18\x02 \x01(\x0e2#.tkd.treatment.v1alpha.DeletePolicyB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06policy\"\x83\x01\n" +
+	"\x1eDeleteSpeciesWithPolicyRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12E\n" +
+	"\x06policy\x18\x02 \x01(\x0e2#.tkd.treatment.v1alpha.DeletePolicyB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06policy\"\xaf\x01\n" +
+	"\x0fSpeciesDeletion\x12B\n" +
+	"\x06impact\x18\x01 \x01(\v2*.tkd.treatment.v1alpha.DeleteSpeciesImpactR\x06impact\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"7\n" +
+	"\x1bListSpeciesDeletionsRequest\x12\x18\n" +
+	"\aspecies\x18\x01 \x01(\tR\aspecies\"d\n" +
+	"\x1cListSpeciesDeletionsResponse\x12D\n" +
+	"\tdeletions\x18\x01 \x03(\v2&.tkd.treatment.v1alpha.SpeciesDeletionR\tdeletions*U\n" +
+	"\tMergeIcon\x12\x1a\n" +
+	"\x16MERGE_ICON_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11MERGE_ICON_TARGET\x10\x01\x12\x15\n" +
+	"\x11MERGE_ICON_SOURCE\x10\x02*~\n" +
+	"\fDeletePolicy\x12\x1d\n" +
+	"\x19DELETE_POLICY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16DELETE_POLICY_RESTRICT\x10\x01\x12\x18\n" +
+	"\x14DELETE_POLICY_DETACH\x10\x02\x12\x19\n" +
+	"\x15DELETE_POLICY_CASCADE\x10\x032\x8e\x02\n" +
+	"\x17SpeciesHierarchyService\x12m\n" +
+	"\x10SetSpeciesParent\x12..tkd.treatment.v1alpha.SetSpeciesParentRequest\x1a\".tkd.treatment.v1alpha.SpeciesNode\"\x05\xb2~\x02\b\x01\x12\x83\x01\n" +
+	"\x13GetSpeciesHierarchy\x121.tkd.treatment.v1alpha.GetSpeciesHierarchyRequest\x1a2.tkd.treatment.v1alpha.GetSpeciesHierarchyResponse\"\x05\xb2~\x02\b\x012\xf9\x04\n" +
+	"\x19SpeciesMaintenanceService\x12n\n" +
+	"\fMergeSpecies\x12*.tkd.treatment.v1alpha.MergeSpeciesRequest\x1a+.tkd.treatment.v1alpha.MergeSpeciesResponse\"\x05\xb2~\x02\b\x01\x12^\n" +
+	"\rRenameSpecies\x12+.tkd.treatment.v1alpha.RenameSpeciesRequest\x1a\x19.tkd.treatment.v1.Species\"\x05\xb2~\x02\b\x01\x12}\n" +
+	"\x14PreviewDeleteSpecies\x122.tkd.treatment.v1alpha.PreviewDeleteSpeciesRequest\x1a*.tkd.treatment.v1alpha.DeleteSpeciesImpact\"\x05\xb2~\x02\b\x01\x12\x83\x01\n" +
+	"\x17DeleteSpeciesWithPolicy\x125.tkd.treatment.v1alpha.DeleteSpeciesWithPolicyRequest\x1a*.tkd.treatment.v1alpha.DeleteSpeciesImpact\"\x05\xb2~\x02\b\x01\x12\x86\x01\n" +
+	"\x14ListSpeciesDeletions\x122.tkd.treatment.v1alpha.ListSpeciesDeletionsRequest\x1a3.tkd.treatment.v1alpha.ListSpeciesDeletionsResponse\"\x05\xb2~\x02\b\x01BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_species_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_species_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_species_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_species_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_species_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_species_proto_rawDesc), len(file_tkd_treatment_v1alpha_species_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_species_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_species_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tkd_treatment_v1alpha_species_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_tkd_treatment_v1alpha_species_proto_goTypes = []any{
+	(MergeIcon)(0),                         // 0: tkd.treatment.v1alpha.MergeIcon
+	(DeletePolicy)(0),                      // 1: tkd.treatment.v1alpha.DeletePolicy
+	(*SpeciesNode)(nil),                    // 2: tkd.treatment.v1alpha.SpeciesNode
+	(*SetSpeciesParentRequest)(nil),        // 3: tkd.treatment.v1alpha.SetSpeciesParentRequest
+	(*GetSpeciesHierarchyRequest)(nil),     // 4: tkd.treatment.v1alpha.GetSpeciesHierarchyRequest
+	(*GetSpeciesHierarchyResponse)(nil),    // 5: tkd.treatment.v1alpha.GetSpeciesHierarchyResponse
+	(*MergeSpeciesRequest)(nil),            // 6: tkd.treatment.v1alpha.MergeSpeciesRequest
+	(*MergeSpeciesResponse)(nil),           // 7: tkd.treatment.v1alpha.MergeSpeciesResponse
+	(*PriceConflict)(nil),                  // 8: tkd.treatment.v1alpha.PriceConflict
+	(*RenameSpeciesRequest)(nil),           // 9: tkd.treatment.v1alpha.RenameSpeciesRequest
+	(*SpeciesRenamedEvent)(nil),            // 10: tkd.treatment.v1alpha.SpeciesRenamedEvent
+	(*DeleteSpeciesImpact)(nil),            // 11: tkd.treatment.v1alpha.DeleteSpeciesImpact
+	(*PreviewDeleteSpeciesRequest)(nil),    // 12: tkd.treatment.v1alpha.PreviewDeleteSpeciesRequest
+	(*DeleteSpeciesWithPolicyRequest)(nil), // 13: tkd.treatment.v1alpha.DeleteSpeciesWithPolicyRequest
+	(*SpeciesDeletion)(nil),                // 14: tkd.treatment.v1alpha.SpeciesDeletion
+	(*ListSpeciesDeletionsRequest)(nil),    // 15: tkd.treatment.v1alpha.ListSpeciesDeletionsRequest
+	(*ListSpeciesDeletionsResponse)(nil),   // 16: tkd.treatment.v1alpha.ListSpeciesDeletionsResponse
+	(*v1.Species)(nil),                     // 17: tkd.treatment.v1.Species
+	(*timestamppb.Timestamp)(nil),          // 18: google.protobuf.Timestamp
+}
+var file_tkd_treatment_v1alpha_species_proto_depIdxs = []int32{
+	2,  // 0: tkd.treatment.v1alpha.GetSpeciesHierarchyResponse.species:type_name -> tkd.treatment.v1alpha.SpeciesNode
+	0,  // 1: tkd.treatment.v1alpha.MergeSpeciesRequest.icon:type_name -> tkd.treatment.v1alpha.MergeIcon
+	17, // 2: tkd.treatment.v1alpha.MergeSpeciesResponse.species:type_name -> tkd.treatment.v1.Species
+	8,  // 3: tkd.treatment.v1alpha.MergeSpeciesResponse.price_conflicts:type_name -> tkd.treatment.v1alpha.PriceConflict
+	18, // 4: tkd.treatment.v1alpha.PriceConflict.valid_from:type_name -> google.protobuf.Timestamp
+	18, // 5: tkd.treatment.v1alpha.SpeciesRenamedEvent.alias_expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: tkd.treatment.v1alpha.DeleteSpeciesImpact.policy:type_name -> tkd.treatment.v1alpha.DeletePolicy
+	1,  // 7: tkd.treatment.v1alpha.PreviewDeleteSpeciesRequest.policy:type_name -> tkd.treatment.v1alpha.DeletePolicy
+	1,  // 8: tkd.treatment.v1alpha.DeleteSpeciesWithPolicyRequest.policy:type_name -> tkd.treatment.v1alpha.DeletePolicy
+	11, // 9: tkd.treatment.v1alpha.SpeciesDeletion.impact:type_name -> tkd.treatment.v1alpha.DeleteSpeciesImpact
+	18, // 10: tkd.treatment.v1alpha.SpeciesDeletion.deleted_at:type_name -> google.protobuf.Timestamp
+	14, // 11: tkd.treatment.v1alpha.ListSpeciesDeletionsResponse.deletions:type_name -> tkd.treatment.v1alpha.SpeciesDeletion
+	3,  // 12: tkd.treatment.v1alpha.SpeciesHierarchyService.SetSpeciesParent:input_type -> tkd.treatment.v1alpha.SetSpeciesParentRequest
+	4,  // 13: tkd.treatment.v1alpha.SpeciesHierarchyService.GetSpeciesHierarchy:input_type -> tkd.treatment.v1alpha.GetSpeciesHierarchyRequest
+	6,  // 14: tkd.treatment.v1alpha.SpeciesMaintenanceService.MergeSpecies:input_type -> tkd.treatment.v1alpha.MergeSpeciesRequest
+	9,  // 15: tkd.treatment.v1alpha.SpeciesMaintenanceService.RenameSpecies:input_type -> tkd.treatment.v1alpha.RenameSpeciesRequest
+	12, // 16: tkd.treatment.v1alpha.SpeciesMaintenanceService.PreviewDeleteSpecies:input_type -> tkd.treatment.v1alpha.PreviewDeleteSpeciesRequest
+	13, // 17: tkd.treatment.v1alpha.SpeciesMaintenanceService.DeleteSpeciesWithPolicy:input_type -> tkd.treatment.v1alpha.DeleteSpeciesWithPolicyRequest
+	15, // 18: tkd.treatment.v1alpha.SpeciesMaintenanceService.ListSpeciesDeletions:input_type -> tkd.treatment.v1alpha.ListSpeciesDeletionsRequest
+	2,  // 19: tkd.treatment.v1alpha.SpeciesHierarchyService.SetSpeciesParent:output_type -> tkd.treatment.v1alpha.SpeciesNode
+	5,  // 20: tkd.treatment.v1alpha.SpeciesHierarchyService.GetSpeciesHierarchy:output_type -> tkd.treatment.v1alpha.GetSpeciesHierarchyResponse
+	7,  // 21: tkd.treatment.v1alpha.SpeciesMaintenanceService.MergeSpecies:output_type -> tkd.treatment.v1alpha.MergeSpeciesResponse
+	17, // 22: tkd.treatment.v1alpha.SpeciesMaintenanceService.RenameSpecies:output_type -> tkd.treatment.v1.Species
+	11, // 23: tkd.treatment.v1alpha.SpeciesMaintenanceService.PreviewDeleteSpecies:output_type -> tkd.treatment.v1alpha.DeleteSpeciesImpact
+	11, // 24: tkd.treatment.v1alpha.SpeciesMaintenanceService.DeleteSpeciesWithPolicy:output_type -> tkd.treatment.v1alpha.DeleteSpeciesImpact
+	16, // 25: tkd.treatment.v1alpha.SpeciesMaintenanceService.ListSpeciesDeletions:output_type -> tkd.treatment.v1alpha.ListSpeciesDeletionsResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_species_proto_init() }
+func file_tkd_treatment_v1alpha_species_proto_init() {
+	if File_tkd_treatment_v1alpha_species_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_species_proto_rawDesc), len(file_tkd_treatment_v1alpha_species_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_species_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_species_proto_depIdxs,
+		EnumInfos:         file_tkd_treatment_v1alpha_species_proto_enumTypes,
+		MessageInfos:      file_tkd_treatment_v1alpha_species_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_species_proto = out.File
+	file_tkd_treatment_v1alpha_species_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_species_proto_depIdxs = nil
+}
@@ -0,0 +1,796 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tkd/treatment/v1alpha/treatment.proto
+
+package treatmentv1alpha
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReferenceKind int32
+
+const (
+	ReferenceKind_REFERENCE_KIND_UNSPECIFIED ReferenceKind = 0
+	// An employee ID in allowed_employees and preferred_employees.
+	ReferenceKind_REFERENCE_KIND_EMPLOYEE ReferenceKind = 1
+	// A resource name in resources.
+	ReferenceKind_REFERENCE_KIND_RESOURCE ReferenceKind = 2
+)
+
+// Enum value maps for ReferenceKind.
+var (
+	ReferenceKind_name = map[int32]string{
+		0: "REFERENCE_KIND_UNSPECIFIED",
+		1: "REFERENCE_KIND_EMPLOYEE",
+		2: "REFERENCE_KIND_RESOURCE",
+	}
+	ReferenceKind_value = map[string]int32{
+		"REFERENCE_KIND_UNSPECIFIED": 0,
+		"REFERENCE_KIND_EMPLOYEE":    1,
+		"REFERENCE_KIND_RESOURCE":    2,
+	}
+)
+
+func (x ReferenceKind) Enum() *ReferenceKind {
+	p := new(ReferenceKind)
+	*p = x
+	return p
+}
+
+func (x ReferenceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReferenceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tkd_treatment_v1alpha_treatment_proto_enumTypes[0].Descriptor()
+}
+
+func (ReferenceKind) Type() protoreflect.EnumType {
+	return &file_tkd_treatment_v1alpha_treatment_proto_enumTypes[0]
+}
+
+func (x ReferenceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReferenceKind.Descriptor instead.
+func (ReferenceKind) EnumDescriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{0}
+}
+
+type RenameTreatmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTreatmentRequest) Reset() {
+	*x = RenameTreatmentRequest{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTreatmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTreatmentRequest) ProtoMessage() {}
+
+func (x *RenameTreatmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTreatmentRequest.ProtoReflect.Descriptor instead.
+func (*RenameTreatmentRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RenameTreatmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameTreatmentRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// TreatmentRenamedEvent is published when a treatment has been renamed.
+type TreatmentRenamedEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OldName string                 `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// AliasExpiresAt is the time until the old name is still resolved to
+	// the new one.
+	AliasExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=alias_expires_at,json=aliasExpiresAt,proto3" json:"alias_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TreatmentRenamedEvent) Reset() {
+	*x = TreatmentRenamedEvent{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentRenamedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentRenamedEvent) ProtoMessage() {}
+
+func (x *TreatmentRenamedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentRenamedEvent.ProtoReflect.Descriptor instead.
+func (*TreatmentRenamedEvent) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TreatmentRenamedEvent) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *TreatmentRenamedEvent) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *TreatmentRenamedEvent) GetAliasExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AliasExpiresAt
+	}
+	return nil
+}
+
+// TreatmentMutation is a single create, update or delete operation of
+// BatchMutateTreatments.
+type TreatmentMutation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*TreatmentMutation_Create
+	//	*TreatmentMutation_Update
+	//	*TreatmentMutation_Delete
+	Kind          isTreatmentMutation_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreatmentMutation) Reset() {
+	*x = TreatmentMutation{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentMutation) ProtoMessage() {}
+
+func (x *TreatmentMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentMutation.ProtoReflect.Descriptor instead.
+func (*TreatmentMutation) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TreatmentMutation) GetKind() isTreatmentMutation_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *TreatmentMutation) GetCreate() *v1.Treatment {
+	if x != nil {
+		if x, ok := x.Kind.(*TreatmentMutation_Create); ok {
+			return x.Create
+		}
+	}
+	return nil
+}
+
+func (x *TreatmentMutation) GetUpdate() *v1.UpdateTreatmentRequest {
+	if x != nil {
+		if x, ok := x.Kind.(*TreatmentMutation_Update); ok {
+			return x.Update
+		}
+	}
+	return nil
+}
+
+func (x *TreatmentMutation) GetDelete() string {
+	if x != nil {
+		if x, ok := x.Kind.(*TreatmentMutation_Delete); ok {
+			return x.Delete
+		}
+	}
+	return ""
+}
+
+type isTreatmentMutation_Kind interface {
+	isTreatmentMutation_Kind()
+}
+
+type TreatmentMutation_Create struct {
+	Create *v1.Treatment `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type TreatmentMutation_Update struct {
+	Update *v1.UpdateTreatmentRequest `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type TreatmentMutation_Delete struct {
+	// Delete is the name of the treatment to delete.
+	Delete string `protobuf:"bytes,3,opt,name=delete,proto3,oneof"`
+}
+
+func (*TreatmentMutation_Create) isTreatmentMutation_Kind() {}
+
+func (*TreatmentMutation_Update) isTreatmentMutation_Kind() {}
+
+func (*TreatmentMutation_Delete) isTreatmentMutation_Kind() {}
+
+type BatchMutateTreatmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mutations     []*TreatmentMutation   `protobuf:"bytes,1,rep,name=mutations,proto3" json:"mutations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMutateTreatmentsRequest) Reset() {
+	*x = BatchMutateTreatmentsRequest{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMutateTreatmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMutateTreatmentsRequest) ProtoMessage() {}
+
+func (x *BatchMutateTreatmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMutateTreatmentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchMutateTreatmentsRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchMutateTreatmentsRequest) GetMutations() []*TreatmentMutation {
+	if x != nil {
+		return x.Mutations
+	}
+	return nil
+}
+
+type TreatmentMutationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Index is the index of the mutation in the request.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Treatment holds the created or updated treatment. Unset for
+	// delete operations.
+	Treatment *v1.Treatment `protobuf:"bytes,2,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	// Error is set if the mutation failed.
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreatmentMutationResult) Reset() {
+	*x = TreatmentMutationResult{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentMutationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentMutationResult) ProtoMessage() {}
+
+func (x *TreatmentMutationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentMutationResult.ProtoReflect.Descriptor instead.
+func (*TreatmentMutationResult) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TreatmentMutationResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TreatmentMutationResult) GetTreatment() *v1.Treatment {
+	if x != nil {
+		return x.Treatment
+	}
+	return nil
+}
+
+func (x *TreatmentMutationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchMutateTreatmentsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*TreatmentMutationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMutateTreatmentsResponse) Reset() {
+	*x = BatchMutateTreatmentsResponse{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMutateTreatmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMutateTreatmentsResponse) ProtoMessage() {}
+
+func (x *BatchMutateTreatmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMutateTreatmentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchMutateTreatmentsResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchMutateTreatmentsResponse) GetResults() []*TreatmentMutationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ReplaceReferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  ReferenceKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=tkd.treatment.v1alpha.ReferenceKind" json:"kind,omitempty"`
+	// From is the employee ID or resource name to replace.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// To is the replacement. If empty, from is removed.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// DryRun may be set to true to only report the changes without
+	// applying them.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceReferencesRequest) Reset() {
+	*x = ReplaceReferencesRequest{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceReferencesRequest) ProtoMessage() {}
+
+func (x *ReplaceReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceReferencesRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReplaceReferencesRequest) GetKind() ReferenceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReferenceKind_REFERENCE_KIND_UNSPECIFIED
+}
+
+func (x *ReplaceReferencesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ReplaceReferencesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ReplaceReferencesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReplaceReferencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ChangedTreatments holds all treatments that are changed, with the
+	// replacement applied.
+	ChangedTreatments []*v1.Treatment `protobuf:"bytes,1,rep,name=changed_treatments,json=changedTreatments,proto3" json:"changed_treatments,omitempty"`
+	// Conflicts holds the names of all treatments where removing an employee
+	// would leave allowed_employees empty, which would allow all employees
+	// to handle the treatment. The request is rejected if there are conflicts.
+	Conflicts     []string `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceReferencesResponse) Reset() {
+	*x = ReplaceReferencesResponse{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceReferencesResponse) ProtoMessage() {}
+
+func (x *ReplaceReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceReferencesResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReplaceReferencesResponse) GetChangedTreatments() []*v1.Treatment {
+	if x != nil {
+		return x.ChangedTreatments
+	}
+	return nil
+}
+
+func (x *ReplaceReferencesResponse) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CloneTreatmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the name of the treatment to clone.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// NewName is the name of the new treatment.
+	NewName string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// Overrides holds values that replace the values of the cloned treatment.
+	// Only fields listed in override_mask are applied. The name field is
+	// ignored.
+	Overrides    *v1.Treatment          `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	OverrideMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=override_mask,json=overrideMask,proto3" json:"override_mask,omitempty"`
+	// Template marks the new treatment as a template. Templates cannot be
+	// booked and are hidden from ListTreatments.
+	Template      bool `protobuf:"varint,5,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneTreatmentRequest) Reset() {
+	*x = CloneTreatmentRequest{}
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneTreatmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneTreatmentRequest) ProtoMessage() {}
+
+func (x *CloneTreatmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tkd_treatment_v1alpha_treatment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneTreatmentRequest.ProtoReflect.Descriptor instead.
+func (*CloneTreatmentRequest) Descriptor() ([]byte, []int) {
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CloneTreatmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneTreatmentRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *CloneTreatmentRequest) GetOverrides() *v1.Treatment {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *CloneTreatmentRequest) GetOverrideMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.OverrideMask
+	}
+	return nil
+}
+
+func (x *CloneTreatmentRequest) GetTemplate() bool {
+	if x != nil {
+		return x.Template
+	}
+	return false
+}
+
+var File_tkd_treatment_v1alpha_treatment_proto protoreflect.FileDescriptor
+
+const file_tkd_treatment_v1alpha_treatment_proto_rawDesc = "" +
+	"\n" +
+	"%tkd/treatment/v1alpha/treatment.proto\x12\x15tkd.treatment.v1alpha\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1etkd/common/v1/descriptor.proto\x1a tkd/treatment/v1/treatment.proto\x1a#tkd/treatment/v1alpha/details.proto\"W\n" +
+	"\x16RenameTreatmentRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12!\n" +
+	"\bnew_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\anewName\"\x93\x01\n" +
+	"\x15TreatmentRenamedEvent\x12\x19\n" +
+	"\bold_name\x18\x01 \x01(\tR\aoldName\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\x12D\n" +
+	"\x10alias_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0ealiasExpiresAt\"\xb7\x01\n" +
+	"\x11TreatmentMutation\x125\n" +
+	"\x06create\x18\x01 \x01(\v2\x1b.tkd.treatment.v1.TreatmentH\x00R\x06create\x12B\n" +
+	"\x06update\x18\x02 \x01(\v2(.tkd.treatment.v1.UpdateTreatmentRequestH\x00R\x06update\x12\x18\n" +
+	"\x06delete\x18\x03 \x01(\tH\x00R\x06deleteB\r\n" +
+	"\x04kind\x12\x05\xbaH\x02\b\x01\"p\n" +
+	"\x1cBatchMutateTreatmentsRequest\x12P\n" +
+	"\tmutations\x18\x01 \x03(\v2(.tkd.treatment.v1alpha.TreatmentMutationB\b\xbaH\x05\x92\x01\x02\b\x01R\tmutations\"\x80\x01\n" +
+	"\x17TreatmentMutationResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x129\n" +
+	"\ttreatment\x18\x02 \x01(\v2\x1b.tkd.treatment.v1.TreatmentR\ttreatment\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"i\n" +
+	"\x1dBatchMutateTreatmentsResponse\x12H\n" +
+	"\aresults\x18\x01 \x03(\v2..tkd.treatment.v1alpha.TreatmentMutationResultR\aresults\"\xa5\x01\n" +
+	"\x18ReplaceReferencesRequest\x12D\n" +
+	"\x04kind\x18\x01 \x01(\x0e2$.tkd.treatment.v1alpha.ReferenceKindB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04kind\x12\x1a\n" +
+	"\x04from\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\x85\x01\n" +
+	"\x19ReplaceReferencesResponse\x12J\n" +
+	"\x12changed_treatments\x18\x01 \x03(\v2\x1b.tkd.treatment.v1.TreatmentR\x11changedTreatments\x12\x1c\n" +
+	"\tconflicts\x18\x02 \x03(\tR\tconflicts\"\xee\x01\n" +
+	"\x15CloneTreatmentRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12!\n" +
+	"\bnew_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\anewName\x129\n" +
+	"\toverrides\x18\x03 \x01(\v2\x1b.tkd.treatment.v1.TreatmentR\toverrides\x12?\n" +
+	"\roverride_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\foverrideMask\x12\x1a\n" +
+	"\btemplate\x18\x05 \x01(\bR\btemplate*i\n" +
+	"\rReferenceKind\x12\x1e\n" +
+	"\x1aREFERENCE_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REFERENCE_KIND_EMPLOYEE\x10\x01\x12\x1b\n" +
+	"\x17REFERENCE_KIND_RESOURCE\x10\x022\x82\x04\n" +
+	"\x1bTreatmentMaintenanceService\x12d\n" +
+	"\x0fRenameTreatment\x12-.tkd.treatment.v1alpha.RenameTreatmentRequest\x1a\x1b.tkd.treatment.v1.Treatment\"\x05\xb2~\x02\b\x01\x12\x89\x01\n" +
+	"\x15BatchMutateTreatments\x123.tkd.treatment.v1alpha.BatchMutateTreatmentsRequest\x1a4.tkd.treatment.v1alpha.BatchMutateTreatmentsResponse\"\x05\xb2~\x02\b\x01\x12}\n" +
+	"\x11ReplaceReferences\x12/.tkd.treatment.v1alpha.ReplaceReferencesRequest\x1a0.tkd.treatment.v1alpha.ReplaceReferencesResponse\"\x05\xb2~\x02\b\x01\x12r\n" +
+	"\x0eCloneTreatment\x12,.tkd.treatment.v1alpha.CloneTreatmentRequest\x1a+.tkd.treatment.v1alpha.TreatmentWithDetails\"\x05\xb2~\x02\b\x01BbZ`github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha;treatmentv1alphab\x06proto3"
+
+var (
+	file_tkd_treatment_v1alpha_treatment_proto_rawDescOnce sync.Once
+	file_tkd_treatment_v1alpha_treatment_proto_rawDescData []byte
+)
+
+func file_tkd_treatment_v1alpha_treatment_proto_rawDescGZIP() []byte {
+	file_tkd_treatment_v1alpha_treatment_proto_rawDescOnce.Do(func() {
+		file_tkd_treatment_v1alpha_treatment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_treatment_proto_rawDesc), len(file_tkd_treatment_v1alpha_treatment_proto_rawDesc)))
+	})
+	return file_tkd_treatment_v1alpha_treatment_proto_rawDescData
+}
+
+var file_tkd_treatment_v1alpha_treatment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tkd_treatment_v1alpha_treatment_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_tkd_treatment_v1alpha_treatment_proto_goTypes = []any{
+	(ReferenceKind)(0),                    // 0: tkd.treatment.v1alpha.ReferenceKind
+	(*RenameTreatmentRequest)(nil),        // 1: tkd.treatment.v1alpha.RenameTreatmentRequest
+	(*TreatmentRenamedEvent)(nil),         // 2: tkd.treatment.v1alpha.TreatmentRenamedEvent
+	(*TreatmentMutation)(nil),             // 3: tkd.treatment.v1alpha.TreatmentMutation
+	(*BatchMutateTreatmentsRequest)(nil),  // 4: tkd.treatment.v1alpha.BatchMutateTreatmentsRequest
+	(*TreatmentMutationResult)(nil),       // 5: tkd.treatment.v1alpha.TreatmentMutationResult
+	(*BatchMutateTreatmentsResponse)(nil), // 6: tkd.treatment.v1alpha.BatchMutateTreatmentsResponse
+	(*ReplaceReferencesRequest)(nil),      // 7: tkd.treatment.v1alpha.ReplaceReferencesRequest
+	(*ReplaceReferencesResponse)(nil),     // 8: tkd.treatment.v1alpha.ReplaceReferencesResponse
+	(*CloneTreatmentRequest)(nil),         // 9: tkd.treatment.v1alpha.CloneTreatmentRequest
+	(*timestamppb.Timestamp)(nil),         // 10: google.protobuf.Timestamp
+	(*v1.Treatment)(nil),                  // 11: tkd.treatment.v1.Treatment
+	(*v1.UpdateTreatmentRequest)(nil),     // 12: tkd.treatment.v1.UpdateTreatmentRequest
+	(*fieldmaskpb.FieldMask)(nil),         // 13: google.protobuf.FieldMask
+	(*TreatmentWithDetails)(nil),          // 14: tkd.treatment.v1alpha.TreatmentWithDetails
+}
+var file_tkd_treatment_v1alpha_treatment_proto_depIdxs = []int32{
+	10, // 0: tkd.treatment.v1alpha.TreatmentRenamedEvent.alias_expires_at:type_name -> google.protobuf.Timestamp
+	11, // 1: tkd.treatment.v1alpha.TreatmentMutation.create:type_name -> tkd.treatment.v1.Treatment
+	12, // 2: tkd.treatment.v1alpha.TreatmentMutation.update:type_name -> tkd.treatment.v1.UpdateTreatmentRequest
+	3,  // 3: tkd.treatment.v1alpha.BatchMutateTreatmentsRequest.mutations:type_name -> tkd.treatment.v1alpha.TreatmentMutation
+	11, // 4: tkd.treatment.v1alpha.TreatmentMutationResult.treatment:type_name -> tkd.treatment.v1.Treatment
+	5,  // 5: tkd.treatment.v1alpha.BatchMutateTreatmentsResponse.results:type_name -> tkd.treatment.v1alpha.TreatmentMutationResult
+	0,  // 6: tkd.treatment.v1alpha.ReplaceReferencesRequest.kind:type_name -> tkd.treatment.v1alpha.ReferenceKind
+	11, // 7: tkd.treatment.v1alpha.ReplaceReferencesResponse.changed_treatments:type_name -> tkd.treatment.v1.Treatment
+	11, // 8: tkd.treatment.v1alpha.CloneTreatmentRequest.overrides:type_name -> tkd.treatment.v1.Treatment
+	13, // 9: tkd.treatment.v1alpha.CloneTreatmentRequest.override_mask:type_name -> google.protobuf.FieldMask
+	1,  // 10: tkd.treatment.v1alpha.TreatmentMaintenanceService.RenameTreatment:input_type -> tkd.treatment.v1alpha.RenameTreatmentRequest
+	4,  // 11: tkd.treatment.v1alpha.TreatmentMaintenanceService.BatchMutateTreatments:input_type -> tkd.treatment.v1alpha.BatchMutateTreatmentsRequest
+	7,  // 12: tkd.treatment.v1alpha.TreatmentMaintenanceService.ReplaceReferences:input_type -> tkd.treatment.v1alpha.ReplaceReferencesRequest
+	9,  // 13: tkd.treatment.v1alpha.TreatmentMaintenanceService.CloneTreatment:input_type -> tkd.treatment.v1alpha.CloneTreatmentRequest
+	11, // 14: tkd.treatment.v1alpha.TreatmentMaintenanceService.RenameTreatment:output_type -> tkd.treatment.v1.Treatment
+	6,  // 15: tkd.treatment.v1alpha.TreatmentMaintenanceService.BatchMutateTreatments:output_type -> tkd.treatment.v1alpha.BatchMutateTreatmentsResponse
+	8,  // 16: tkd.treatment.v1alpha.TreatmentMaintenanceService.ReplaceReferences:output_type -> tkd.treatment.v1alpha.ReplaceReferencesResponse
+	14, // 17: tkd.treatment.v1alpha.TreatmentMaintenanceService.CloneTreatment:output_type -> tkd.treatment.v1alpha.TreatmentWithDetails
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_tkd_treatment_v1alpha_treatment_proto_init() }
+func file_tkd_treatment_v1alpha_treatment_proto_init() {
+	if File_tkd_treatment_v1alpha_treatment_proto != nil {
+		return
+	}
+	file_tkd_treatment_v1alpha_details_proto_init()
+	file_tkd_treatment_v1alpha_treatment_proto_msgTypes[2].OneofWrappers = []any{
+		(*TreatmentMutation_Create)(nil),
+		(*TreatmentMutation_Update)(nil),
+		(*TreatmentMutation_Delete)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tkd_treatment_v1alpha_treatment_proto_rawDesc), len(file_tkd_treatment_v1alpha_treatment_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tkd_treatment_v1alpha_treatment_proto_goTypes,
+		DependencyIndexes: file_tkd_treatment_v1alpha_treatment_proto_depIdxs,
+		EnumInfos:         file_tkd_treatment_v1alpha_treatment_proto_enumTypes,
+		MessageInfos:      file_tkd_treatment_v1alpha_treatment_proto_msgTypes,
+	}.Build()
+	File_tkd_treatment_v1alpha_treatment_proto = out.File
+	file_tkd_treatment_v1alpha_treatment_proto_goTypes = nil
+	file_tkd_treatment_v1alpha_treatment_proto_depIdxs = nil
+}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/breed.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// BreedServiceName is the fully-qualified name of the BreedService service.
+	BreedServiceName = "tkd.treatment.v1alpha.BreedService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BreedServiceCreateBreedProcedure is the fully-qualified name of the BreedService's CreateBreed
+	// RPC.
+	BreedServiceCreateBreedProcedure = "/tkd.treatment.v1alpha.BreedService/CreateBreed"
+	// BreedServiceGetBreedProcedure is the fully-qualified name of the BreedService's GetBreed RPC.
+	BreedServiceGetBreedProcedure = "/tkd.treatment.v1alpha.BreedService/GetBreed"
+	// BreedServiceListBreedsProcedure is the fully-qualified name of the BreedService's ListBreeds RPC.
+	BreedServiceListBreedsProcedure = "/tkd.treatment.v1alpha.BreedService/ListBreeds"
+	// BreedServiceUpdateBreedProcedure is the fully-qualified name of the BreedService's UpdateBreed
+	// RPC.
+	BreedServiceUpdateBreedProcedure = "/tkd.treatment.v1alpha.BreedService/UpdateBreed"
+	// BreedServiceDeleteBreedProcedure is the fully-qualified name of the BreedService's DeleteBreed
+	// RPC.
+	BreedServiceDeleteBreedProcedure = "/tkd.treatment.v1alpha.BreedService/DeleteBreed"
+	// BreedServiceDetectBreedsProcedure is the fully-qualified name of the BreedService's DetectBreeds
+	// RPC.
+	BreedServiceDetectBreedsProcedure = "/tkd.treatment.v1alpha.BreedService/DetectBreeds"
+)
+
+// BreedServiceClient is a client for the tkd.treatment.v1alpha.BreedService service.
+type BreedServiceClient interface {
+	CreateBreed(context.Context, *connect_go.Request[v1alpha.Breed]) (*connect_go.Response[v1alpha.Breed], error)
+	GetBreed(context.Context, *connect_go.Request[v1alpha.GetBreedRequest]) (*connect_go.Response[v1alpha.Breed], error)
+	ListBreeds(context.Context, *connect_go.Request[v1alpha.ListBreedsRequest]) (*connect_go.Response[v1alpha.ListBreedsResponse], error)
+	UpdateBreed(context.Context, *connect_go.Request[v1alpha.UpdateBreedRequest]) (*connect_go.Response[v1alpha.Breed], error)
+	DeleteBreed(context.Context, *connect_go.Request[v1alpha.DeleteBreedRequest]) (*connect_go.Response[emptypb.Empty], error)
+	// DetectBreeds works like tkd.treatment.v1.SpeciesService.DetectSpecies
+	// but detects breeds using their match words.
+	DetectBreeds(context.Context, *connect_go.Request[v1alpha.DetectBreedsRequest]) (*connect_go.Response[v1alpha.DetectBreedsResponse], error)
+}
+
+// NewBreedServiceClient constructs a client for the tkd.treatment.v1alpha.BreedService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBreedServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) BreedServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &breedServiceClient{
+		createBreed: connect_go.NewClient[v1alpha.Breed, v1alpha.Breed](
+			httpClient,
+			baseURL+BreedServiceCreateBreedProcedure,
+			opts...,
+		),
+		getBreed: connect_go.NewClient[v1alpha.GetBreedRequest, v1alpha.Breed](
+			httpClient,
+			baseURL+BreedServiceGetBreedProcedure,
+			opts...,
+		),
+		listBreeds: connect_go.NewClient[v1alpha.ListBreedsRequest, v1alpha.ListBreedsResponse](
+			httpClient,
+			baseURL+BreedServiceListBreedsProcedure,
+			opts...,
+		),
+		updateBreed: connect_go.NewClient[v1alpha.UpdateBreedRequest, v1alpha.Breed](
+			httpClient,
+			baseURL+BreedServiceUpdateBreedProcedure,
+			opts...,
+		),
+		deleteBreed: connect_go.NewClient[v1alpha.DeleteBreedRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BreedServiceDeleteBreedProcedure,
+			opts...,
+		),
+		detectBreeds: connect_go.NewClient[v1alpha.DetectBreedsRequest, v1alpha.DetectBreedsResponse](
+			httpClient,
+			baseURL+BreedServiceDetectBreedsProcedure,
+			opts...,
+		),
+	}
+}
+
+// breedServiceClient implements BreedServiceClient.
+type breedServiceClient struct {
+	createBreed  *connect_go.Client[v1alpha.Breed, v1alpha.Breed]
+	getBreed     *connect_go.Client[v1alpha.GetBreedRequest, v1alpha.Breed]
+	listBreeds   *connect_go.Client[v1alpha.ListBreedsRequest, v1alpha.ListBreedsResponse]
+	updateBreed  *connect_go.Client[v1alpha.UpdateBreedRequest, v1alpha.Breed]
+	deleteBreed  *connect_go.Client[v1alpha.DeleteBreedRequest, emptypb.Empty]
+	detectBreeds *connect_go.Client[v1alpha.DetectBreedsRequest, v1alpha.DetectBreedsResponse]
+}
+
+// CreateBreed calls tkd.treatment.v1alpha.BreedService.CreateBreed.
+func (c *breedServiceClient) CreateBreed(ctx context.Context, req *connect_go.Request[v1alpha.Breed]) (*connect_go.Response[v1alpha.Breed], error) {
+	return c.createBreed.CallUnary(ctx, req)
+}
+
+// GetBreed calls tkd.treatment.v1alpha.BreedService.GetBreed.
+func (c *breedServiceClient) GetBreed(ctx context.Context, req *connect_go.Request[v1alpha.GetBreedRequest]) (*connect_go.Response[v1alpha.Breed], error) {
+	return c.getBreed.CallUnary(ctx, req)
+}
+
+// ListBreeds calls tkd.treatment.v1alpha.BreedService.ListBreeds.
+func (c *breedServiceClient) ListBreeds(ctx context.Context, req *connect_go.Request[v1alpha.ListBreedsRequest]) (*connect_go.Response[v1alpha.ListBreedsResponse], error) {
+	return c.listBreeds.CallUnary(ctx, req)
+}
+
+// UpdateBreed calls tkd.treatment.v1alpha.BreedService.UpdateBreed.
+func (c *breedServiceClient) UpdateBreed(ctx context.Context, req *connect_go.Request[v1alpha.UpdateBreedRequest]) (*connect_go.Response[v1alpha.Breed], error) {
+	return c.updateBreed.CallUnary(ctx, req)
+}
+
+// DeleteBreed calls tkd.treatment.v1alpha.BreedService.DeleteBreed.
+func (c *breedServiceClient) DeleteBreed(ctx context.Context, req *connect_go.Request[v1alpha.DeleteBreedRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.deleteBreed.CallUnary(ctx, req)
+}
+
+// DetectBreeds calls tkd.treatment.v1alpha.BreedService.DetectBreeds.
+func (c *breedServiceClient) DetectBreeds(ctx context.Context, req *connect_go.Request[v1alpha.DetectBreedsRequest]) (*connect_go.Response[v1alpha.DetectBreedsResponse], error) {
+	return c.detectBreeds.CallUnary(ctx, req)
+}
+
+// BreedServiceHandler is an implementation of the tkd.treatment.v1alpha.BreedService service.
+type BreedServiceHandler interface {
+	CreateBreed(context.Context, *connect_go.Request[v1alpha.Breed]) (*connect_go.Response[v1alpha.Breed], error)
+	GetBreed(context.Context, *connect_go.Request[v1alpha.GetBreedRequest]) (*connect_go.Response[v1alpha.Breed], error)
+	ListBreeds(context.Context, *connect_go.Request[v1alpha.ListBreedsRequest]) (*connect_go.Response[v1alpha.ListBreedsResponse], error)
+	UpdateBreed(context.Context, *connect_go.Request[v1alpha.UpdateBreedRequest]) (*connect_go.Response[v1alpha.Breed], error)
+	DeleteBreed(context.Context, *connect_go.Request[v1alpha.DeleteBreedRequest]) (*connect_go.Response[emptypb.Empty], error)
+	// DetectBreeds works like tkd.treatment.v1.SpeciesService.DetectSpecies
+	// but detects breeds using their match words.
+	DetectBreeds(context.Context, *connect_go.Request[v1alpha.DetectBreedsRequest]) (*connect_go.Response[v1alpha.DetectBreedsResponse], error)
+}
+
+// NewBreedServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBreedServiceHandler(svc BreedServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	breedServiceCreateBreedHandler := connect_go.NewUnaryHandler(
+		BreedServiceCreateBreedProcedure,
+		svc.CreateBreed,
+		opts...,
+	)
+	breedServiceGetBreedHandler := connect_go.NewUnaryHandler(
+		BreedServiceGetBreedProcedure,
+		svc.GetBreed,
+		opts...,
+	)
+	breedServiceListBreedsHandler := connect_go.NewUnaryHandler(
+		BreedServiceListBreedsProcedure,
+		svc.ListBreeds,
+		opts...,
+	)
+	breedServiceUpdateBreedHandler := connect_go.NewUnaryHandler(
+		BreedServiceUpdateBreedProcedure,
+		svc.UpdateBreed,
+		opts...,
+	)
+	breedServiceDeleteBreedHandler := connect_go.NewUnaryHandler(
+		BreedServiceDeleteBreedProcedure,
+		svc.DeleteBreed,
+		opts...,
+	)
+	breedServiceDetectBreedsHandler := connect_go.NewUnaryHandler(
+		BreedServiceDetectBreedsProcedure,
+		svc.DetectBreeds,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.BreedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BreedServiceCreateBreedProcedure:
+			breedServiceCreateBreedHandler.ServeHTTP(w, r)
+		case BreedServiceGetBreedProcedure:
+			breedServiceGetBreedHandler.ServeHTTP(w, r)
+		case BreedServiceListBreedsProcedure:
+			breedServiceListBreedsHandler.ServeHTTP(w, r)
+		case BreedServiceUpdateBreedProcedure:
+			breedServiceUpdateBreedHandler.ServeHTTP(w, r)
+		case BreedServiceDeleteBreedProcedure:
+			breedServiceDeleteBreedHandler.ServeHTTP(w, r)
+		case BreedServiceDetectBreedsProcedure:
+			breedServiceDetectBreedsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBreedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBreedServiceHandler struct{}
+
+func (UnimplementedBreedServiceHandler) CreateBreed(context.Context, *connect_go.Request[v1alpha.Breed]) (*connect_go.Response[v1alpha.Breed], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BreedService.CreateBreed is not implemented"))
+}
+
+func (UnimplementedBreedServiceHandler) GetBreed(context.Context, *connect_go.Request[v1alpha.GetBreedRequest]) (*connect_go.Response[v1alpha.Breed], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BreedService.GetBreed is not implemented"))
+}
+
+func (UnimplementedBreedServiceHandler) ListBreeds(context.Context, *connect_go.Request[v1alpha.ListBreedsRequest]) (*connect_go.Response[v1alpha.ListBreedsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BreedService.ListBreeds is not implemented"))
+}
+
+func (UnimplementedBreedServiceHandler) UpdateBreed(context.Context, *connect_go.Request[v1alpha.UpdateBreedRequest]) (*connect_go.Response[v1alpha.Breed], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BreedService.UpdateBreed is not implemented"))
+}
+
+func (UnimplementedBreedServiceHandler) DeleteBreed(context.Context, *connect_go.Request[v1alpha.DeleteBreedRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BreedService.DeleteBreed is not implemented"))
+}
+
+func (UnimplementedBreedServiceHandler) DetectBreeds(context.Context, *connect_go.Request[v1alpha.DetectBreedsRequest]) (*connect_go.Response[v1alpha.DetectBreedsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BreedService.DetectBreeds is not implemented"))
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/bundle.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// BundleServiceName is the fully-qualified name of the BundleService service.
+	BundleServiceName = "tkd.treatment.v1alpha.BundleService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BundleServiceCreateBundleProcedure is the fully-qualified name of the BundleService's
+	// CreateBundle RPC.
+	BundleServiceCreateBundleProcedure = "/tkd.treatment.v1alpha.BundleService/CreateBundle"
+	// BundleServiceGetBundleProcedure is the fully-qualified name of the BundleService's GetBundle RPC.
+	BundleServiceGetBundleProcedure = "/tkd.treatment.v1alpha.BundleService/GetBundle"
+	// BundleServiceListBundlesProcedure is the fully-qualified name of the BundleService's ListBundles
+	// RPC.
+	BundleServiceListBundlesProcedure = "/tkd.treatment.v1alpha.BundleService/ListBundles"
+	// BundleServiceUpdateBundleProcedure is the fully-qualified name of the BundleService's
+	// UpdateBundle RPC.
+	BundleServiceUpdateBundleProcedure = "/tkd.treatment.v1alpha.BundleService/UpdateBundle"
+	// BundleServiceDeleteBundleProcedure is the fully-qualified name of the BundleService's
+	// DeleteBundle RPC.
+	BundleServiceDeleteBundleProcedure = "/tkd.treatment.v1alpha.BundleService/DeleteBundle"
+)
+
+// BundleServiceClient is a client for the tkd.treatment.v1alpha.BundleService service.
+type BundleServiceClient interface {
+	CreateBundle(context.Context, *connect_go.Request[v1alpha.Bundle]) (*connect_go.Response[v1alpha.Bundle], error)
+	GetBundle(context.Context, *connect_go.Request[v1alpha.GetBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error)
+	ListBundles(context.Context, *connect_go.Request[v1alpha.ListBundlesRequest]) (*connect_go.Response[v1alpha.ListBundlesResponse], error)
+	UpdateBundle(context.Context, *connect_go.Request[v1alpha.UpdateBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error)
+	DeleteBundle(context.Context, *connect_go.Request[v1alpha.DeleteBundleRequest]) (*connect_go.Response[emptypb.Empty], error)
+}
+
+// NewBundleServiceClient constructs a client for the tkd.treatment.v1alpha.BundleService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBundleServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) BundleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &bundleServiceClient{
+		createBundle: connect_go.NewClient[v1alpha.Bundle, v1alpha.Bundle](
+			httpClient,
+			baseURL+BundleServiceCreateBundleProcedure,
+			opts...,
+		),
+		getBundle: connect_go.NewClient[v1alpha.GetBundleRequest, v1alpha.Bundle](
+			httpClient,
+			baseURL+BundleServiceGetBundleProcedure,
+			opts...,
+		),
+		listBundles: connect_go.NewClient[v1alpha.ListBundlesRequest, v1alpha.ListBundlesResponse](
+			httpClient,
+			baseURL+BundleServiceListBundlesProcedure,
+			opts...,
+		),
+		updateBundle: connect_go.NewClient[v1alpha.UpdateBundleRequest, v1alpha.Bundle](
+			httpClient,
+			baseURL+BundleServiceUpdateBundleProcedure,
+			opts...,
+		),
+		deleteBundle: connect_go.NewClient[v1alpha.DeleteBundleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BundleServiceDeleteBundleProcedure,
+			opts...,
+		),
+	}
+}
+
+// bundleServiceClient implements BundleServiceClient.
+type bundleServiceClient struct {
+	createBundle *connect_go.Client[v1alpha.Bundle, v1alpha.Bundle]
+	getBundle    *connect_go.Client[v1alpha.GetBundleRequest, v1alpha.Bundle]
+	listBundles  *connect_go.Client[v1alpha.ListBundlesRequest, v1alpha.ListBundlesResponse]
+	updateBundle *connect_go.Client[v1alpha.UpdateBundleRequest, v1alpha.Bundle]
+	deleteBundle *connect_go.Client[v1alpha.DeleteBundleRequest, emptypb.Empty]
+}
+
+// CreateBundle calls tkd.treatment.v1alpha.BundleService.CreateBundle.
+func (c *bundleServiceClient) CreateBundle(ctx context.Context, req *connect_go.Request[v1alpha.Bundle]) (*connect_go.Response[v1alpha.Bundle], error) {
+	return c.createBundle.CallUnary(ctx, req)
+}
+
+// GetBundle calls tkd.treatment.v1alpha.BundleService.GetBundle.
+func (c *bundleServiceClient) GetBundle(ctx context.Context, req *connect_go.Request[v1alpha.GetBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error) {
+	return c.getBundle.CallUnary(ctx, req)
+}
+
+// ListBundles calls tkd.treatment.v1alpha.BundleService.ListBundles.
+func (c *bundleServiceClient) ListBundles(ctx context.Context, req *connect_go.Request[v1alpha.ListBundlesRequest]) (*connect_go.Response[v1alpha.ListBundlesResponse], error) {
+	return c.listBundles.CallUnary(ctx, req)
+}
+
+// UpdateBundle calls tkd.treatment.v1alpha.BundleService.UpdateBundle.
+func (c *bundleServiceClient) UpdateBundle(ctx context.Context, req *connect_go.Request[v1alpha.UpdateBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error) {
+	return c.updateBundle.CallUnary(ctx, req)
+}
+
+// DeleteBundle calls tkd.treatment.v1alpha.BundleService.DeleteBundle.
+func (c *bundleServiceClient) DeleteBundle(ctx context.Context, req *connect_go.Request[v1alpha.DeleteBundleRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.deleteBundle.CallUnary(ctx, req)
+}
+
+// BundleServiceHandler is an implementation of the tkd.treatment.v1alpha.BundleService service.
+type BundleServiceHandler interface {
+	CreateBundle(context.Context, *connect_go.Request[v1alpha.Bundle]) (*connect_go.Response[v1alpha.Bundle], error)
+	GetBundle(context.Context, *connect_go.Request[v1alpha.GetBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error)
+	ListBundles(context.Context, *connect_go.Request[v1alpha.ListBundlesRequest]) (*connect_go.Response[v1alpha.ListBundlesResponse], error)
+	UpdateBundle(context.Context, *connect_go.Request[v1alpha.UpdateBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error)
+	DeleteBundle(context.Context, *connect_go.Request[v1alpha.DeleteBundleRequest]) (*connect_go.Response[emptypb.Empty], error)
+}
+
+// NewBundleServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBundleServiceHandler(svc BundleServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	bundleServiceCreateBundleHandler := connect_go.NewUnaryHandler(
+		BundleServiceCreateBundleProcedure,
+		svc.CreateBundle,
+		opts...,
+	)
+	bundleServiceGetBundleHandler := connect_go.NewUnaryHandler(
+		BundleServiceGetBundleProcedure,
+		svc.GetBundle,
+		opts...,
+	)
+	bundleServiceListBundlesHandler := connect_go.NewUnaryHandler(
+		BundleServiceListBundlesProcedure,
+		svc.ListBundles,
+		opts...,
+	)
+	bundleServiceUpdateBundleHandler := connect_go.NewUnaryHandler(
+		BundleServiceUpdateBundleProcedure,
+		svc.UpdateBundle,
+		opts...,
+	)
+	bundleServiceDeleteBundleHandler := connect_go.NewUnaryHandler(
+		BundleServiceDeleteBundleProcedure,
+		svc.DeleteBundle,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.BundleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BundleServiceCreateBundleProcedure:
+			bundleServiceCreateBundleHandler.ServeHTTP(w, r)
+		case BundleServiceGetBundleProcedure:
+			bundleServiceGetBundleHandler.ServeHTTP(w, r)
+		case BundleServiceListBundlesProcedure:
+			bundleServiceListBundlesHandler.ServeHTTP(w, r)
+		case BundleServiceUpdateBundleProcedure:
+			bundleServiceUpdateBundleHandler.ServeHTTP(w, r)
+		case BundleServiceDeleteBundleProcedure:
+			bundleServiceDeleteBundleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBundleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBundleServiceHandler struct{}
+
+func (UnimplementedBundleServiceHandler) CreateBundle(context.Context, *connect_go.Request[v1alpha.Bundle]) (*connect_go.Response[v1alpha.Bundle], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BundleService.CreateBundle is not implemented"))
+}
+
+func (UnimplementedBundleServiceHandler) GetBundle(context.Context, *connect_go.Request[v1alpha.GetBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BundleService.GetBundle is not implemented"))
+}
+
+func (UnimplementedBundleServiceHandler) ListBundles(context.Context, *connect_go.Request[v1alpha.ListBundlesRequest]) (*connect_go.Response[v1alpha.ListBundlesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BundleService.ListBundles is not implemented"))
+}
+
+func (UnimplementedBundleServiceHandler) UpdateBundle(context.Context, *connect_go.Request[v1alpha.UpdateBundleRequest]) (*connect_go.Response[v1alpha.Bundle], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BundleService.UpdateBundle is not implemented"))
+}
+
+func (UnimplementedBundleServiceHandler) DeleteBundle(context.Context, *connect_go.Request[v1alpha.DeleteBundleRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.BundleService.DeleteBundle is not implemented"))
+}
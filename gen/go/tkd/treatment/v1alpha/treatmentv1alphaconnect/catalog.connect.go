@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/catalog.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// SelfBookingCatalogServiceName is the fully-qualified name of the SelfBookingCatalogService
+	// service.
+	SelfBookingCatalogServiceName = "tkd.treatment.v1alpha.SelfBookingCatalogService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SelfBookingCatalogServiceGetSelfBookingCatalogProcedure is the fully-qualified name of the
+	// SelfBookingCatalogService's GetSelfBookingCatalog RPC.
+	SelfBookingCatalogServiceGetSelfBookingCatalogProcedure = "/tkd.treatment.v1alpha.SelfBookingCatalogService/GetSelfBookingCatalog"
+)
+
+// SelfBookingCatalogServiceClient is a client for the
+// tkd.treatment.v1alpha.SelfBookingCatalogService service.
+type SelfBookingCatalogServiceClient interface {
+	GetSelfBookingCatalog(context.Context, *connect_go.Request[v1alpha.GetSelfBookingCatalogRequest]) (*connect_go.Response[v1alpha.SelfBookingCatalog], error)
+}
+
+// NewSelfBookingCatalogServiceClient constructs a client for the
+// tkd.treatment.v1alpha.SelfBookingCatalogService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSelfBookingCatalogServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) SelfBookingCatalogServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &selfBookingCatalogServiceClient{
+		getSelfBookingCatalog: connect_go.NewClient[v1alpha.GetSelfBookingCatalogRequest, v1alpha.SelfBookingCatalog](
+			httpClient,
+			baseURL+SelfBookingCatalogServiceGetSelfBookingCatalogProcedure,
+			opts...,
+		),
+	}
+}
+
+// selfBookingCatalogServiceClient implements SelfBookingCatalogServiceClient.
+type selfBookingCatalogServiceClient struct {
+	getSelfBookingCatalog *connect_go.Client[v1alpha.GetSelfBookingCatalogRequest, v1alpha.SelfBookingCatalog]
+}
+
+// GetSelfBookingCatalog calls
+// tkd.treatment.v1alpha.SelfBookingCatalogService.GetSelfBookingCatalog.
+func (c *selfBookingCatalogServiceClient) GetSelfBookingCatalog(ctx context.Context, req *connect_go.Request[v1alpha.GetSelfBookingCatalogRequest]) (*connect_go.Response[v1alpha.SelfBookingCatalog], error) {
+	return c.getSelfBookingCatalog.CallUnary(ctx, req)
+}
+
+// SelfBookingCatalogServiceHandler is an implementation of the
+// tkd.treatment.v1alpha.SelfBookingCatalogService service.
+type SelfBookingCatalogServiceHandler interface {
+	GetSelfBookingCatalog(context.Context, *connect_go.Request[v1alpha.GetSelfBookingCatalogRequest]) (*connect_go.Response[v1alpha.SelfBookingCatalog], error)
+}
+
+// NewSelfBookingCatalogServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSelfBookingCatalogServiceHandler(svc SelfBookingCatalogServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	selfBookingCatalogServiceGetSelfBookingCatalogHandler := connect_go.NewUnaryHandler(
+		SelfBookingCatalogServiceGetSelfBookingCatalogProcedure,
+		svc.GetSelfBookingCatalog,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.SelfBookingCatalogService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SelfBookingCatalogServiceGetSelfBookingCatalogProcedure:
+			selfBookingCatalogServiceGetSelfBookingCatalogHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSelfBookingCatalogServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSelfBookingCatalogServiceHandler struct{}
+
+func (UnimplementedSelfBookingCatalogServiceHandler) GetSelfBookingCatalog(context.Context, *connect_go.Request[v1alpha.GetSelfBookingCatalogRequest]) (*connect_go.Response[v1alpha.SelfBookingCatalog], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SelfBookingCatalogService.GetSelfBookingCatalog is not implemented"))
+}
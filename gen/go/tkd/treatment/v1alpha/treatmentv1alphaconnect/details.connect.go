@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/details.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// TreatmentDetailsServiceName is the fully-qualified name of the TreatmentDetailsService service.
+	TreatmentDetailsServiceName = "tkd.treatment.v1alpha.TreatmentDetailsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TreatmentDetailsServiceGetTreatmentDetailsProcedure is the fully-qualified name of the
+	// TreatmentDetailsService's GetTreatmentDetails RPC.
+	TreatmentDetailsServiceGetTreatmentDetailsProcedure = "/tkd.treatment.v1alpha.TreatmentDetailsService/GetTreatmentDetails"
+	// TreatmentDetailsServiceListTreatmentsWithDetailsProcedure is the fully-qualified name of the
+	// TreatmentDetailsService's ListTreatmentsWithDetails RPC.
+	TreatmentDetailsServiceListTreatmentsWithDetailsProcedure = "/tkd.treatment.v1alpha.TreatmentDetailsService/ListTreatmentsWithDetails"
+	// TreatmentDetailsServiceUpdateTreatmentDetailsProcedure is the fully-qualified name of the
+	// TreatmentDetailsService's UpdateTreatmentDetails RPC.
+	TreatmentDetailsServiceUpdateTreatmentDetailsProcedure = "/tkd.treatment.v1alpha.TreatmentDetailsService/UpdateTreatmentDetails"
+	// TreatmentDetailsServiceCheckPrerequisitesProcedure is the fully-qualified name of the
+	// TreatmentDetailsService's CheckPrerequisites RPC.
+	TreatmentDetailsServiceCheckPrerequisitesProcedure = "/tkd.treatment.v1alpha.TreatmentDetailsService/CheckPrerequisites"
+	// TreatmentDetailsServiceRenderInstructionsProcedure is the fully-qualified name of the
+	// TreatmentDetailsService's RenderInstructions RPC.
+	TreatmentDetailsServiceRenderInstructionsProcedure = "/tkd.treatment.v1alpha.TreatmentDetailsService/RenderInstructions"
+)
+
+// TreatmentDetailsServiceClient is a client for the tkd.treatment.v1alpha.TreatmentDetailsService
+// service.
+type TreatmentDetailsServiceClient interface {
+	// GetTreatmentDetails returns the details, including the pre-op
+	// requirements, of a treatment. They are not part of the response of
+	// tkd.treatment.v1.TreatmentService.GetTreatment since
+	// tkd.treatment.v1.Treatment is defined in the shared API module.
+	GetTreatmentDetails(context.Context, *connect_go.Request[v1alpha.GetTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error)
+	// ListTreatmentsWithDetails works like tkd.treatment.v1.TreatmentService.ListTreatments
+	// but also returns the details of each treatment and supports filtering
+	// by pre-op requirements and patient eligibility.
+	ListTreatmentsWithDetails(context.Context, *connect_go.Request[v1alpha.ListTreatmentsWithDetailsRequest]) (*connect_go.Response[v1alpha.ListTreatmentsWithDetailsResponse], error)
+	UpdateTreatmentDetails(context.Context, *connect_go.Request[v1alpha.UpdateTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error)
+	// CheckPrerequisites evaluates the prerequisites of a treatment against
+	// the past treatments of a patient.
+	CheckPrerequisites(context.Context, *connect_go.Request[v1alpha.CheckPrerequisitesRequest]) (*connect_go.Response[v1alpha.CheckPrerequisitesResponse], error)
+	// RenderInstructions returns the preparation instructions of one or more
+	// treatments with all placeholders filled in.
+	RenderInstructions(context.Context, *connect_go.Request[v1alpha.RenderInstructionsRequest]) (*connect_go.Response[v1alpha.RenderInstructionsResponse], error)
+}
+
+// NewTreatmentDetailsServiceClient constructs a client for the
+// tkd.treatment.v1alpha.TreatmentDetailsService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTreatmentDetailsServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) TreatmentDetailsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &treatmentDetailsServiceClient{
+		getTreatmentDetails: connect_go.NewClient[v1alpha.GetTreatmentDetailsRequest, v1alpha.TreatmentDetails](
+			httpClient,
+			baseURL+TreatmentDetailsServiceGetTreatmentDetailsProcedure,
+			opts...,
+		),
+		listTreatmentsWithDetails: connect_go.NewClient[v1alpha.ListTreatmentsWithDetailsRequest, v1alpha.ListTreatmentsWithDetailsResponse](
+			httpClient,
+			baseURL+TreatmentDetailsServiceListTreatmentsWithDetailsProcedure,
+			opts...,
+		),
+		updateTreatmentDetails: connect_go.NewClient[v1alpha.UpdateTreatmentDetailsRequest, v1alpha.TreatmentDetails](
+			httpClient,
+			baseURL+TreatmentDetailsServiceUpdateTreatmentDetailsProcedure,
+			opts...,
+		),
+		checkPrerequisites: connect_go.NewClient[v1alpha.CheckPrerequisitesRequest, v1alpha.CheckPrerequisitesResponse](
+			httpClient,
+			baseURL+TreatmentDetailsServiceCheckPrerequisitesProcedure,
+			opts...,
+		),
+		renderInstructions: connect_go.NewClient[v1alpha.RenderInstructionsRequest, v1alpha.RenderInstructionsResponse](
+			httpClient,
+			baseURL+TreatmentDetailsServiceRenderInstructionsProcedure,
+			opts...,
+		),
+	}
+}
+
+// treatmentDetailsServiceClient implements TreatmentDetailsServiceClient.
+type treatmentDetailsServiceClient struct {
+	getTreatmentDetails       *connect_go.Client[v1alpha.GetTreatmentDetailsRequest, v1alpha.TreatmentDetails]
+	listTreatmentsWithDetails *connect_go.Client[v1alpha.ListTreatmentsWithDetailsRequest, v1alpha.ListTreatmentsWithDetailsResponse]
+	updateTreatmentDetails    *connect_go.Client[v1alpha.UpdateTreatmentDetailsRequest, v1alpha.TreatmentDetails]
+	checkPrerequisites        *connect_go.Client[v1alpha.CheckPrerequisitesRequest, v1alpha.CheckPrerequisitesResponse]
+	renderInstructions        *connect_go.Client[v1alpha.RenderInstructionsRequest, v1alpha.RenderInstructionsResponse]
+}
+
+// GetTreatmentDetails calls tkd.treatment.v1alpha.TreatmentDetailsService.GetTreatmentDetails.
+func (c *treatmentDetailsServiceClient) GetTreatmentDetails(ctx context.Context, req *connect_go.Request[v1alpha.GetTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error) {
+	return c.getTreatmentDetails.CallUnary(ctx, req)
+}
+
+// ListTreatmentsWithDetails calls
+// tkd.treatment.v1alpha.TreatmentDetailsService.ListTreatmentsWithDetails.
+func (c *treatmentDetailsServiceClient) ListTreatmentsWithDetails(ctx context.Context, req *connect_go.Request[v1alpha.ListTreatmentsWithDetailsRequest]) (*connect_go.Response[v1alpha.ListTreatmentsWithDetailsResponse], error) {
+	return c.listTreatmentsWithDetails.CallUnary(ctx, req)
+}
+
+// UpdateTreatmentDetails calls
+// tkd.treatment.v1alpha.TreatmentDetailsService.UpdateTreatmentDetails.
+func (c *treatmentDetailsServiceClient) UpdateTreatmentDetails(ctx context.Context, req *connect_go.Request[v1alpha.UpdateTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error) {
+	return c.updateTreatmentDetails.CallUnary(ctx, req)
+}
+
+// CheckPrerequisites calls tkd.treatment.v1alpha.TreatmentDetailsService.CheckPrerequisites.
+func (c *treatmentDetailsServiceClient) CheckPrerequisites(ctx context.Context, req *connect_go.Request[v1alpha.CheckPrerequisitesRequest]) (*connect_go.Response[v1alpha.CheckPrerequisitesResponse], error) {
+	return c.checkPrerequisites.CallUnary(ctx, req)
+}
+
+// RenderInstructions calls tkd.treatment.v1alpha.TreatmentDetailsService.RenderInstructions.
+func (c *treatmentDetailsServiceClient) RenderInstructions(ctx context.Context, req *connect_go.Request[v1alpha.RenderInstructionsRequest]) (*connect_go.Response[v1alpha.RenderInstructionsResponse], error) {
+	return c.renderInstructions.CallUnary(ctx, req)
+}
+
+// TreatmentDetailsServiceHandler is an implementation of the
+// tkd.treatment.v1alpha.TreatmentDetailsService service.
+type TreatmentDetailsServiceHandler interface {
+	// GetTreatmentDetails returns the details, including the pre-op
+	// requirements, of a treatment. They are not part of the response of
+	// tkd.treatment.v1.TreatmentService.GetTreatment since
+	// tkd.treatment.v1.Treatment is defined in the shared API module.
+	GetTreatmentDetails(context.Context, *connect_go.Request[v1alpha.GetTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error)
+	// ListTreatmentsWithDetails works like tkd.treatment.v1.TreatmentService.ListTreatments
+	// but also returns the details of each treatment and supports filtering
+	// by pre-op requirements and patient eligibility.
+	ListTreatmentsWithDetails(context.Context, *connect_go.Request[v1alpha.ListTreatmentsWithDetailsRequest]) (*connect_go.Response[v1alpha.ListTreatmentsWithDetailsResponse], error)
+	UpdateTreatmentDetails(context.Context, *connect_go.Request[v1alpha.UpdateTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error)
+	// CheckPrerequisites evaluates the prerequisites of a treatment against
+	// the past treatments of a patient.
+	CheckPrerequisites(context.Context, *connect_go.Request[v1alpha.CheckPrerequisitesRequest]) (*connect_go.Response[v1alpha.CheckPrerequisitesResponse], error)
+	// RenderInstructions returns the preparation instructions of one or more
+	// treatments with all placeholders filled in.
+	RenderInstructions(context.Context, *connect_go.Request[v1alpha.RenderInstructionsRequest]) (*connect_go.Response[v1alpha.RenderInstructionsResponse], error)
+}
+
+// NewTreatmentDetailsServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTreatmentDetailsServiceHandler(svc TreatmentDetailsServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	treatmentDetailsServiceGetTreatmentDetailsHandler := connect_go.NewUnaryHandler(
+		TreatmentDetailsServiceGetTreatmentDetailsProcedure,
+		svc.GetTreatmentDetails,
+		opts...,
+	)
+	treatmentDetailsServiceListTreatmentsWithDetailsHandler := connect_go.NewUnaryHandler(
+		TreatmentDetailsServiceListTreatmentsWithDetailsProcedure,
+		svc.ListTreatmentsWithDetails,
+		opts...,
+	)
+	treatmentDetailsServiceUpdateTreatmentDetailsHandler := connect_go.NewUnaryHandler(
+		TreatmentDetailsServiceUpdateTreatmentDetailsProcedure,
+		svc.UpdateTreatmentDetails,
+		opts...,
+	)
+	treatmentDetailsServiceCheckPrerequisitesHandler := connect_go.NewUnaryHandler(
+		TreatmentDetailsServiceCheckPrerequisitesProcedure,
+		svc.CheckPrerequisites,
+		opts...,
+	)
+	treatmentDetailsServiceRenderInstructionsHandler := connect_go.NewUnaryHandler(
+		TreatmentDetailsServiceRenderInstructionsProcedure,
+		svc.RenderInstructions,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.TreatmentDetailsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TreatmentDetailsServiceGetTreatmentDetailsProcedure:
+			treatmentDetailsServiceGetTreatmentDetailsHandler.ServeHTTP(w, r)
+		case TreatmentDetailsServiceListTreatmentsWithDetailsProcedure:
+			treatmentDetailsServiceListTreatmentsWithDetailsHandler.ServeHTTP(w, r)
+		case TreatmentDetailsServiceUpdateTreatmentDetailsProcedure:
+			treatmentDetailsServiceUpdateTreatmentDetailsHandler.ServeHTTP(w, r)
+		case TreatmentDetailsServiceCheckPrerequisitesProcedure:
+			treatmentDetailsServiceCheckPrerequisitesHandler.ServeHTTP(w, r)
+		case TreatmentDetailsServiceRenderInstructionsProcedure:
+			treatmentDetailsServiceRenderInstructionsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTreatmentDetailsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTreatmentDetailsServiceHandler struct{}
+
+func (UnimplementedTreatmentDetailsServiceHandler) GetTreatmentDetails(context.Context, *connect_go.Request[v1alpha.GetTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentDetailsService.GetTreatmentDetails is not implemented"))
+}
+
+func (UnimplementedTreatmentDetailsServiceHandler) ListTreatmentsWithDetails(context.Context, *connect_go.Request[v1alpha.ListTreatmentsWithDetailsRequest]) (*connect_go.Response[v1alpha.ListTreatmentsWithDetailsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentDetailsService.ListTreatmentsWithDetails is not implemented"))
+}
+
+func (UnimplementedTreatmentDetailsServiceHandler) UpdateTreatmentDetails(context.Context, *connect_go.Request[v1alpha.UpdateTreatmentDetailsRequest]) (*connect_go.Response[v1alpha.TreatmentDetails], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentDetailsService.UpdateTreatmentDetails is not implemented"))
+}
+
+func (UnimplementedTreatmentDetailsServiceHandler) CheckPrerequisites(context.Context, *connect_go.Request[v1alpha.CheckPrerequisitesRequest]) (*connect_go.Response[v1alpha.CheckPrerequisitesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentDetailsService.CheckPrerequisites is not implemented"))
+}
+
+func (UnimplementedTreatmentDetailsServiceHandler) RenderInstructions(context.Context, *connect_go.Request[v1alpha.RenderInstructionsRequest]) (*connect_go.Response[v1alpha.RenderInstructionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentDetailsService.RenderInstructions is not implemented"))
+}
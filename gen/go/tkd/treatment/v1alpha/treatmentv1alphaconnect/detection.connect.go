@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/detection.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// DetectionServiceName is the fully-qualified name of the DetectionService service.
+	DetectionServiceName = "tkd.treatment.v1alpha.DetectionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DetectionServiceDetectTreatmentsProcedure is the fully-qualified name of the DetectionService's
+	// DetectTreatments RPC.
+	DetectionServiceDetectTreatmentsProcedure = "/tkd.treatment.v1alpha.DetectionService/DetectTreatments"
+	// DetectionServiceAnalyzeMatchRulesProcedure is the fully-qualified name of the DetectionService's
+	// AnalyzeMatchRules RPC.
+	DetectionServiceAnalyzeMatchRulesProcedure = "/tkd.treatment.v1alpha.DetectionService/AnalyzeMatchRules"
+	// DetectionServiceCreateDetectionFixtureProcedure is the fully-qualified name of the
+	// DetectionService's CreateDetectionFixture RPC.
+	DetectionServiceCreateDetectionFixtureProcedure = "/tkd.treatment.v1alpha.DetectionService/CreateDetectionFixture"
+	// DetectionServiceListDetectionFixturesProcedure is the fully-qualified name of the
+	// DetectionService's ListDetectionFixtures RPC.
+	DetectionServiceListDetectionFixturesProcedure = "/tkd.treatment.v1alpha.DetectionService/ListDetectionFixtures"
+	// DetectionServiceDeleteDetectionFixtureProcedure is the fully-qualified name of the
+	// DetectionService's DeleteDetectionFixture RPC.
+	DetectionServiceDeleteDetectionFixtureProcedure = "/tkd.treatment.v1alpha.DetectionService/DeleteDetectionFixture"
+	// DetectionServiceEvaluateDetectionFixturesProcedure is the fully-qualified name of the
+	// DetectionService's EvaluateDetectionFixtures RPC.
+	DetectionServiceEvaluateDetectionFixturesProcedure = "/tkd.treatment.v1alpha.DetectionService/EvaluateDetectionFixtures"
+)
+
+// DetectionServiceClient is a client for the tkd.treatment.v1alpha.DetectionService service.
+type DetectionServiceClient interface {
+	// DetectTreatments scores all treatments against the input values and
+	// returns the matching ones, best match first.
+	DetectTreatments(context.Context, *connect_go.Request[v1alpha.DetectTreatmentsRequest]) (*connect_go.Response[v1alpha.DetectTreatmentsResponse], error)
+	// AnalyzeMatchRules reports conflicting, subsumed and shadowed match
+	// words of species, treatments and breeds.
+	AnalyzeMatchRules(context.Context, *connect_go.Request[v1alpha.AnalyzeMatchRulesRequest]) (*connect_go.Response[v1alpha.AnalyzeMatchRulesResponse], error)
+	// CreateDetectionFixture stores a new labelled detection example.
+	CreateDetectionFixture(context.Context, *connect_go.Request[v1alpha.DetectionFixture]) (*connect_go.Response[v1alpha.DetectionFixture], error)
+	ListDetectionFixtures(context.Context, *connect_go.Request[v1alpha.ListDetectionFixturesRequest]) (*connect_go.Response[v1alpha.ListDetectionFixturesResponse], error)
+	DeleteDetectionFixture(context.Context, *connect_go.Request[v1alpha.DeleteDetectionFixtureRequest]) (*connect_go.Response[emptypb.Empty], error)
+	// EvaluateDetectionFixtures evaluates stored fixtures against the
+	// current match rules.
+	EvaluateDetectionFixtures(context.Context, *connect_go.Request[v1alpha.EvaluateDetectionFixturesRequest]) (*connect_go.Response[v1alpha.EvaluateDetectionFixturesResponse], error)
+}
+
+// NewDetectionServiceClient constructs a client for the tkd.treatment.v1alpha.DetectionService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDetectionServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) DetectionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &detectionServiceClient{
+		detectTreatments: connect_go.NewClient[v1alpha.DetectTreatmentsRequest, v1alpha.DetectTreatmentsResponse](
+			httpClient,
+			baseURL+DetectionServiceDetectTreatmentsProcedure,
+			opts...,
+		),
+		analyzeMatchRules: connect_go.NewClient[v1alpha.AnalyzeMatchRulesRequest, v1alpha.AnalyzeMatchRulesResponse](
+			httpClient,
+			baseURL+DetectionServiceAnalyzeMatchRulesProcedure,
+			opts...,
+		),
+		createDetectionFixture: connect_go.NewClient[v1alpha.DetectionFixture, v1alpha.DetectionFixture](
+			httpClient,
+			baseURL+DetectionServiceCreateDetectionFixtureProcedure,
+			opts...,
+		),
+		listDetectionFixtures: connect_go.NewClient[v1alpha.ListDetectionFixturesRequest, v1alpha.ListDetectionFixturesResponse](
+			httpClient,
+			baseURL+DetectionServiceListDetectionFixturesProcedure,
+			opts...,
+		),
+		deleteDetectionFixture: connect_go.NewClient[v1alpha.DeleteDetectionFixtureRequest, emptypb.Empty](
+			httpClient,
+			baseURL+DetectionServiceDeleteDetectionFixtureProcedure,
+			opts...,
+		),
+		evaluateDetectionFixtures: connect_go.NewClient[v1alpha.EvaluateDetectionFixturesRequest, v1alpha.EvaluateDetectionFixturesResponse](
+			httpClient,
+			baseURL+DetectionServiceEvaluateDetectionFixturesProcedure,
+			opts...,
+		),
+	}
+}
+
+// detectionServiceClient implements DetectionServiceClient.
+type detectionServiceClient struct {
+	detectTreatments          *connect_go.Client[v1alpha.DetectTreatmentsRequest, v1alpha.DetectTreatmentsResponse]
+	analyzeMatchRules         *connect_go.Client[v1alpha.AnalyzeMatchRulesRequest, v1alpha.AnalyzeMatchRulesResponse]
+	createDetectionFixture    *connect_go.Client[v1alpha.DetectionFixture, v1alpha.DetectionFixture]
+	listDetectionFixtures     *connect_go.Client[v1alpha.ListDetectionFixturesRequest, v1alpha.ListDetectionFixturesResponse]
+	deleteDetectionFixture    *connect_go.Client[v1alpha.DeleteDetectionFixtureRequest, emptypb.Empty]
+	evaluateDetectionFixtures *connect_go.Client[v1alpha.EvaluateDetectionFixturesRequest, v1alpha.EvaluateDetectionFixturesResponse]
+}
+
+// DetectTreatments calls tkd.treatment.v1alpha.DetectionService.DetectTreatments.
+func (c *detectionServiceClient) DetectTreatments(ctx context.Context, req *connect_go.Request[v1alpha.DetectTreatmentsRequest]) (*connect_go.Response[v1alpha.DetectTreatmentsResponse], error) {
+	return c.detectTreatments.CallUnary(ctx, req)
+}
+
+// AnalyzeMatchRules calls tkd.treatment.v1alpha.DetectionService.AnalyzeMatchRules.
+func (c *detectionServiceClient) AnalyzeMatchRules(ctx context.Context, req *connect_go.Request[v1alpha.AnalyzeMatchRulesRequest]) (*connect_go.Response[v1alpha.AnalyzeMatchRulesResponse], error) {
+	return c.analyzeMatchRules.CallUnary(ctx, req)
+}
+
+// CreateDetectionFixture calls tkd.treatment.v1alpha.DetectionService.CreateDetectionFixture.
+func (c *detectionServiceClient) CreateDetectionFixture(ctx context.Context, req *connect_go.Request[v1alpha.DetectionFixture]) (*connect_go.Response[v1alpha.DetectionFixture], error) {
+	return c.createDetectionFixture.CallUnary(ctx, req)
+}
+
+// ListDetectionFixtures calls tkd.treatment.v1alpha.DetectionService.ListDetectionFixtures.
+func (c *detectionServiceClient) ListDetectionFixtures(ctx context.Context, req *connect_go.Request[v1alpha.ListDetectionFixturesRequest]) (*connect_go.Response[v1alpha.ListDetectionFixturesResponse], error) {
+	return c.listDetectionFixtures.CallUnary(ctx, req)
+}
+
+// DeleteDetectionFixture calls tkd.treatment.v1alpha.DetectionService.DeleteDetectionFixture.
+func (c *detectionServiceClient) DeleteDetectionFixture(ctx context.Context, req *connect_go.Request[v1alpha.DeleteDetectionFixtureRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.deleteDetectionFixture.CallUnary(ctx, req)
+}
+
+// EvaluateDetectionFixtures calls tkd.treatment.v1alpha.DetectionService.EvaluateDetectionFixtures.
+func (c *detectionServiceClient) EvaluateDetectionFixtures(ctx context.Context, req *connect_go.Request[v1alpha.EvaluateDetectionFixturesRequest]) (*connect_go.Response[v1alpha.EvaluateDetectionFixturesResponse], error) {
+	return c.evaluateDetectionFixtures.CallUnary(ctx, req)
+}
+
+// DetectionServiceHandler is an implementation of the tkd.treatment.v1alpha.DetectionService
+// service.
+type DetectionServiceHandler interface {
+	// DetectTreatments scores all treatments against the input values and
+	// returns the matching ones, best match first.
+	DetectTreatments(context.Context, *connect_go.Request[v1alpha.DetectTreatmentsRequest]) (*connect_go.Response[v1alpha.DetectTreatmentsResponse], error)
+	// AnalyzeMatchRules reports conflicting, subsumed and shadowed match
+	// words of species, treatments and breeds.
+	AnalyzeMatchRules(context.Context, *connect_go.Request[v1alpha.AnalyzeMatchRulesRequest]) (*connect_go.Response[v1alpha.AnalyzeMatchRulesResponse], error)
+	// CreateDetectionFixture stores a new labelled detection example.
+	CreateDetectionFixture(context.Context, *connect_go.Request[v1alpha.DetectionFixture]) (*connect_go.Response[v1alpha.DetectionFixture], error)
+	ListDetectionFixtures(context.Context, *connect_go.Request[v1alpha.ListDetectionFixturesRequest]) (*connect_go.Response[v1alpha.ListDetectionFixturesResponse], error)
+	DeleteDetectionFixture(context.Context, *connect_go.Request[v1alpha.DeleteDetectionFixtureRequest]) (*connect_go.Response[emptypb.Empty], error)
+	// EvaluateDetectionFixtures evaluates stored fixtures against the
+	// current match rules.
+	EvaluateDetectionFixtures(context.Context, *connect_go.Request[v1alpha.EvaluateDetectionFixturesRequest]) (*connect_go.Response[v1alpha.EvaluateDetectionFixturesResponse], error)
+}
+
+// NewDetectionServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDetectionServiceHandler(svc DetectionServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	detectionServiceDetectTreatmentsHandler := connect_go.NewUnaryHandler(
+		DetectionServiceDetectTreatmentsProcedure,
+		svc.DetectTreatments,
+		opts...,
+	)
+	detectionServiceAnalyzeMatchRulesHandler := connect_go.NewUnaryHandler(
+		DetectionServiceAnalyzeMatchRulesProcedure,
+		svc.AnalyzeMatchRules,
+		opts...,
+	)
+	detectionServiceCreateDetectionFixtureHandler := connect_go.NewUnaryHandler(
+		DetectionServiceCreateDetectionFixtureProcedure,
+		svc.CreateDetectionFixture,
+		opts...,
+	)
+	detectionServiceListDetectionFixturesHandler := connect_go.NewUnaryHandler(
+		DetectionServiceListDetectionFixturesProcedure,
+		svc.ListDetectionFixtures,
+		opts...,
+	)
+	detectionServiceDeleteDetectionFixtureHandler := connect_go.NewUnaryHandler(
+		DetectionServiceDeleteDetectionFixtureProcedure,
+		svc.DeleteDetectionFixture,
+		opts...,
+	)
+	detectionServiceEvaluateDetectionFixturesHandler := connect_go.NewUnaryHandler(
+		DetectionServiceEvaluateDetectionFixturesProcedure,
+		svc.EvaluateDetectionFixtures,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.DetectionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DetectionServiceDetectTreatmentsProcedure:
+			detectionServiceDetectTreatmentsHandler.ServeHTTP(w, r)
+		case DetectionServiceAnalyzeMatchRulesProcedure:
+			detectionServiceAnalyzeMatchRulesHandler.ServeHTTP(w, r)
+		case DetectionServiceCreateDetectionFixtureProcedure:
+			detectionServiceCreateDetectionFixtureHandler.ServeHTTP(w, r)
+		case DetectionServiceListDetectionFixturesProcedure:
+			detectionServiceListDetectionFixturesHandler.ServeHTTP(w, r)
+		case DetectionServiceDeleteDetectionFixtureProcedure:
+			detectionServiceDeleteDetectionFixtureHandler.ServeHTTP(w, r)
+		case DetectionServiceEvaluateDetectionFixturesProcedure:
+			detectionServiceEvaluateDetectionFixturesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDetectionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDetectionServiceHandler struct{}
+
+func (UnimplementedDetectionServiceHandler) DetectTreatments(context.Context, *connect_go.Request[v1alpha.DetectTreatmentsRequest]) (*connect_go.Response[v1alpha.DetectTreatmentsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.DetectionService.DetectTreatments is not implemented"))
+}
+
+func (UnimplementedDetectionServiceHandler) AnalyzeMatchRules(context.Context, *connect_go.Request[v1alpha.AnalyzeMatchRulesRequest]) (*connect_go.Response[v1alpha.AnalyzeMatchRulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.DetectionService.AnalyzeMatchRules is not implemented"))
+}
+
+func (UnimplementedDetectionServiceHandler) CreateDetectionFixture(context.Context, *connect_go.Request[v1alpha.DetectionFixture]) (*connect_go.Response[v1alpha.DetectionFixture], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.DetectionService.CreateDetectionFixture is not implemented"))
+}
+
+func (UnimplementedDetectionServiceHandler) ListDetectionFixtures(context.Context, *connect_go.Request[v1alpha.ListDetectionFixturesRequest]) (*connect_go.Response[v1alpha.ListDetectionFixturesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.DetectionService.ListDetectionFixtures is not implemented"))
+}
+
+func (UnimplementedDetectionServiceHandler) DeleteDetectionFixture(context.Context, *connect_go.Request[v1alpha.DeleteDetectionFixtureRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.DetectionService.DeleteDetectionFixture is not implemented"))
+}
+
+func (UnimplementedDetectionServiceHandler) EvaluateDetectionFixtures(context.Context, *connect_go.Request[v1alpha.EvaluateDetectionFixturesRequest]) (*connect_go.Response[v1alpha.EvaluateDetectionFixturesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.DetectionService.EvaluateDetectionFixtures is not implemented"))
+}
@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/pricing.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// PricingServiceName is the fully-qualified name of the PricingService service.
+	PricingServiceName = "tkd.treatment.v1alpha.PricingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PricingServiceCreatePriceProcedure is the fully-qualified name of the PricingService's
+	// CreatePrice RPC.
+	PricingServiceCreatePriceProcedure = "/tkd.treatment.v1alpha.PricingService/CreatePrice"
+	// PricingServiceListPricesProcedure is the fully-qualified name of the PricingService's ListPrices
+	// RPC.
+	PricingServiceListPricesProcedure = "/tkd.treatment.v1alpha.PricingService/ListPrices"
+	// PricingServiceDeletePriceProcedure is the fully-qualified name of the PricingService's
+	// DeletePrice RPC.
+	PricingServiceDeletePriceProcedure = "/tkd.treatment.v1alpha.PricingService/DeletePrice"
+	// PricingServiceGetEffectivePriceProcedure is the fully-qualified name of the PricingService's
+	// GetEffectivePrice RPC.
+	PricingServiceGetEffectivePriceProcedure = "/tkd.treatment.v1alpha.PricingService/GetEffectivePrice"
+)
+
+// PricingServiceClient is a client for the tkd.treatment.v1alpha.PricingService service.
+type PricingServiceClient interface {
+	CreatePrice(context.Context, *connect_go.Request[v1alpha.Price]) (*connect_go.Response[v1alpha.Price], error)
+	ListPrices(context.Context, *connect_go.Request[v1alpha.ListPricesRequest]) (*connect_go.Response[v1alpha.ListPricesResponse], error)
+	DeletePrice(context.Context, *connect_go.Request[v1alpha.DeletePriceRequest]) (*connect_go.Response[emptypb.Empty], error)
+	// GetEffectivePrice returns the price of a treatment that is valid
+	// at a given time.
+	GetEffectivePrice(context.Context, *connect_go.Request[v1alpha.GetEffectivePriceRequest]) (*connect_go.Response[v1alpha.Price], error)
+}
+
+// NewPricingServiceClient constructs a client for the tkd.treatment.v1alpha.PricingService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPricingServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) PricingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &pricingServiceClient{
+		createPrice: connect_go.NewClient[v1alpha.Price, v1alpha.Price](
+			httpClient,
+			baseURL+PricingServiceCreatePriceProcedure,
+			opts...,
+		),
+		listPrices: connect_go.NewClient[v1alpha.ListPricesRequest, v1alpha.ListPricesResponse](
+			httpClient,
+			baseURL+PricingServiceListPricesProcedure,
+			opts...,
+		),
+		deletePrice: connect_go.NewClient[v1alpha.DeletePriceRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PricingServiceDeletePriceProcedure,
+			opts...,
+		),
+		getEffectivePrice: connect_go.NewClient[v1alpha.GetEffectivePriceRequest, v1alpha.Price](
+			httpClient,
+			baseURL+PricingServiceGetEffectivePriceProcedure,
+			opts...,
+		),
+	}
+}
+
+// pricingServiceClient implements PricingServiceClient.
+type pricingServiceClient struct {
+	createPrice       *connect_go.Client[v1alpha.Price, v1alpha.Price]
+	listPrices        *connect_go.Client[v1alpha.ListPricesRequest, v1alpha.ListPricesResponse]
+	deletePrice       *connect_go.Client[v1alpha.DeletePriceRequest, emptypb.Empty]
+	getEffectivePrice *connect_go.Client[v1alpha.GetEffectivePriceRequest, v1alpha.Price]
+}
+
+// CreatePrice calls tkd.treatment.v1alpha.PricingService.CreatePrice.
+func (c *pricingServiceClient) CreatePrice(ctx context.Context, req *connect_go.Request[v1alpha.Price]) (*connect_go.Response[v1alpha.Price], error) {
+	return c.createPrice.CallUnary(ctx, req)
+}
+
+// ListPrices calls tkd.treatment.v1alpha.PricingService.ListPrices.
+func (c *pricingServiceClient) ListPrices(ctx context.Context, req *connect_go.Request[v1alpha.ListPricesRequest]) (*connect_go.Response[v1alpha.ListPricesResponse], error) {
+	return c.listPrices.CallUnary(ctx, req)
+}
+
+// DeletePrice calls tkd.treatment.v1alpha.PricingService.DeletePrice.
+func (c *pricingServiceClient) DeletePrice(ctx context.Context, req *connect_go.Request[v1alpha.DeletePriceRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return c.deletePrice.CallUnary(ctx, req)
+}
+
+// GetEffectivePrice calls tkd.treatment.v1alpha.PricingService.GetEffectivePrice.
+func (c *pricingServiceClient) GetEffectivePrice(ctx context.Context, req *connect_go.Request[v1alpha.GetEffectivePriceRequest]) (*connect_go.Response[v1alpha.Price], error) {
+	return c.getEffectivePrice.CallUnary(ctx, req)
+}
+
+// PricingServiceHandler is an implementation of the tkd.treatment.v1alpha.PricingService service.
+type PricingServiceHandler interface {
+	CreatePrice(context.Context, *connect_go.Request[v1alpha.Price]) (*connect_go.Response[v1alpha.Price], error)
+	ListPrices(context.Context, *connect_go.Request[v1alpha.ListPricesRequest]) (*connect_go.Response[v1alpha.ListPricesResponse], error)
+	DeletePrice(context.Context, *connect_go.Request[v1alpha.DeletePriceRequest]) (*connect_go.Response[emptypb.Empty], error)
+	// GetEffectivePrice returns the price of a treatment that is valid
+	// at a given time.
+	GetEffectivePrice(context.Context, *connect_go.Request[v1alpha.GetEffectivePriceRequest]) (*connect_go.Response[v1alpha.Price], error)
+}
+
+// NewPricingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPricingServiceHandler(svc PricingServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	pricingServiceCreatePriceHandler := connect_go.NewUnaryHandler(
+		PricingServiceCreatePriceProcedure,
+		svc.CreatePrice,
+		opts...,
+	)
+	pricingServiceListPricesHandler := connect_go.NewUnaryHandler(
+		PricingServiceListPricesProcedure,
+		svc.ListPrices,
+		opts...,
+	)
+	pricingServiceDeletePriceHandler := connect_go.NewUnaryHandler(
+		PricingServiceDeletePriceProcedure,
+		svc.DeletePrice,
+		opts...,
+	)
+	pricingServiceGetEffectivePriceHandler := connect_go.NewUnaryHandler(
+		PricingServiceGetEffectivePriceProcedure,
+		svc.GetEffectivePrice,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.PricingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PricingServiceCreatePriceProcedure:
+			pricingServiceCreatePriceHandler.ServeHTTP(w, r)
+		case PricingServiceListPricesProcedure:
+			pricingServiceListPricesHandler.ServeHTTP(w, r)
+		case PricingServiceDeletePriceProcedure:
+			pricingServiceDeletePriceHandler.ServeHTTP(w, r)
+		case PricingServiceGetEffectivePriceProcedure:
+			pricingServiceGetEffectivePriceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPricingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPricingServiceHandler struct{}
+
+func (UnimplementedPricingServiceHandler) CreatePrice(context.Context, *connect_go.Request[v1alpha.Price]) (*connect_go.Response[v1alpha.Price], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.PricingService.CreatePrice is not implemented"))
+}
+
+func (UnimplementedPricingServiceHandler) ListPrices(context.Context, *connect_go.Request[v1alpha.ListPricesRequest]) (*connect_go.Response[v1alpha.ListPricesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.PricingService.ListPrices is not implemented"))
+}
+
+func (UnimplementedPricingServiceHandler) DeletePrice(context.Context, *connect_go.Request[v1alpha.DeletePriceRequest]) (*connect_go.Response[emptypb.Empty], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.PricingService.DeletePrice is not implemented"))
+}
+
+func (UnimplementedPricingServiceHandler) GetEffectivePrice(context.Context, *connect_go.Request[v1alpha.GetEffectivePriceRequest]) (*connect_go.Response[v1alpha.Price], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.PricingService.GetEffectivePrice is not implemented"))
+}
@@ -0,0 +1,346 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/species.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// SpeciesHierarchyServiceName is the fully-qualified name of the SpeciesHierarchyService service.
+	SpeciesHierarchyServiceName = "tkd.treatment.v1alpha.SpeciesHierarchyService"
+	// SpeciesMaintenanceServiceName is the fully-qualified name of the SpeciesMaintenanceService
+	// service.
+	SpeciesMaintenanceServiceName = "tkd.treatment.v1alpha.SpeciesMaintenanceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SpeciesHierarchyServiceSetSpeciesParentProcedure is the fully-qualified name of the
+	// SpeciesHierarchyService's SetSpeciesParent RPC.
+	SpeciesHierarchyServiceSetSpeciesParentProcedure = "/tkd.treatment.v1alpha.SpeciesHierarchyService/SetSpeciesParent"
+	// SpeciesHierarchyServiceGetSpeciesHierarchyProcedure is the fully-qualified name of the
+	// SpeciesHierarchyService's GetSpeciesHierarchy RPC.
+	SpeciesHierarchyServiceGetSpeciesHierarchyProcedure = "/tkd.treatment.v1alpha.SpeciesHierarchyService/GetSpeciesHierarchy"
+	// SpeciesMaintenanceServiceMergeSpeciesProcedure is the fully-qualified name of the
+	// SpeciesMaintenanceService's MergeSpecies RPC.
+	SpeciesMaintenanceServiceMergeSpeciesProcedure = "/tkd.treatment.v1alpha.SpeciesMaintenanceService/MergeSpecies"
+	// SpeciesMaintenanceServiceRenameSpeciesProcedure is the fully-qualified name of the
+	// SpeciesMaintenanceService's RenameSpecies RPC.
+	SpeciesMaintenanceServiceRenameSpeciesProcedure = "/tkd.treatment.v1alpha.SpeciesMaintenanceService/RenameSpecies"
+	// SpeciesMaintenanceServicePreviewDeleteSpeciesProcedure is the fully-qualified name of the
+	// SpeciesMaintenanceService's PreviewDeleteSpecies RPC.
+	SpeciesMaintenanceServicePreviewDeleteSpeciesProcedure = "/tkd.treatment.v1alpha.SpeciesMaintenanceService/PreviewDeleteSpecies"
+	// SpeciesMaintenanceServiceDeleteSpeciesWithPolicyProcedure is the fully-qualified name of the
+	// SpeciesMaintenanceService's DeleteSpeciesWithPolicy RPC.
+	SpeciesMaintenanceServiceDeleteSpeciesWithPolicyProcedure = "/tkd.treatment.v1alpha.SpeciesMaintenanceService/DeleteSpeciesWithPolicy"
+	// SpeciesMaintenanceServiceListSpeciesDeletionsProcedure is the fully-qualified name of the
+	// SpeciesMaintenanceService's ListSpeciesDeletions RPC.
+	SpeciesMaintenanceServiceListSpeciesDeletionsProcedure = "/tkd.treatment.v1alpha.SpeciesMaintenanceService/ListSpeciesDeletions"
+)
+
+// SpeciesHierarchyServiceClient is a client for the tkd.treatment.v1alpha.SpeciesHierarchyService
+// service.
+type SpeciesHierarchyServiceClient interface {
+	// SetSpeciesParent moves a species into a group. Changes that would
+	// create a cycle are rejected.
+	SetSpeciesParent(context.Context, *connect_go.Request[v1alpha.SetSpeciesParentRequest]) (*connect_go.Response[v1alpha.SpeciesNode], error)
+	GetSpeciesHierarchy(context.Context, *connect_go.Request[v1alpha.GetSpeciesHierarchyRequest]) (*connect_go.Response[v1alpha.GetSpeciesHierarchyResponse], error)
+}
+
+// NewSpeciesHierarchyServiceClient constructs a client for the
+// tkd.treatment.v1alpha.SpeciesHierarchyService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSpeciesHierarchyServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) SpeciesHierarchyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &speciesHierarchyServiceClient{
+		setSpeciesParent: connect_go.NewClient[v1alpha.SetSpeciesParentRequest, v1alpha.SpeciesNode](
+			httpClient,
+			baseURL+SpeciesHierarchyServiceSetSpeciesParentProcedure,
+			opts...,
+		),
+		getSpeciesHierarchy: connect_go.NewClient[v1alpha.GetSpeciesHierarchyRequest, v1alpha.GetSpeciesHierarchyResponse](
+			httpClient,
+			baseURL+SpeciesHierarchyServiceGetSpeciesHierarchyProcedure,
+			opts...,
+		),
+	}
+}
+
+// speciesHierarchyServiceClient implements SpeciesHierarchyServiceClient.
+type speciesHierarchyServiceClient struct {
+	setSpeciesParent    *connect_go.Client[v1alpha.SetSpeciesParentRequest, v1alpha.SpeciesNode]
+	getSpeciesHierarchy *connect_go.Client[v1alpha.GetSpeciesHierarchyRequest, v1alpha.GetSpeciesHierarchyResponse]
+}
+
+// SetSpeciesParent calls tkd.treatment.v1alpha.SpeciesHierarchyService.SetSpeciesParent.
+func (c *speciesHierarchyServiceClient) SetSpeciesParent(ctx context.Context, req *connect_go.Request[v1alpha.SetSpeciesParentRequest]) (*connect_go.Response[v1alpha.SpeciesNode], error) {
+	return c.setSpeciesParent.CallUnary(ctx, req)
+}
+
+// GetSpeciesHierarchy calls tkd.treatment.v1alpha.SpeciesHierarchyService.GetSpeciesHierarchy.
+func (c *speciesHierarchyServiceClient) GetSpeciesHierarchy(ctx context.Context, req *connect_go.Request[v1alpha.GetSpeciesHierarchyRequest]) (*connect_go.Response[v1alpha.GetSpeciesHierarchyResponse], error) {
+	return c.getSpeciesHierarchy.CallUnary(ctx, req)
+}
+
+// SpeciesHierarchyServiceHandler is an implementation of the
+// tkd.treatment.v1alpha.SpeciesHierarchyService service.
+type SpeciesHierarchyServiceHandler interface {
+	// SetSpeciesParent moves a species into a group. Changes that would
+	// create a cycle are rejected.
+	SetSpeciesParent(context.Context, *connect_go.Request[v1alpha.SetSpeciesParentRequest]) (*connect_go.Response[v1alpha.SpeciesNode], error)
+	GetSpeciesHierarchy(context.Context, *connect_go.Request[v1alpha.GetSpeciesHierarchyRequest]) (*connect_go.Response[v1alpha.GetSpeciesHierarchyResponse], error)
+}
+
+// NewSpeciesHierarchyServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSpeciesHierarchyServiceHandler(svc SpeciesHierarchyServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	speciesHierarchyServiceSetSpeciesParentHandler := connect_go.NewUnaryHandler(
+		SpeciesHierarchyServiceSetSpeciesParentProcedure,
+		svc.SetSpeciesParent,
+		opts...,
+	)
+	speciesHierarchyServiceGetSpeciesHierarchyHandler := connect_go.NewUnaryHandler(
+		SpeciesHierarchyServiceGetSpeciesHierarchyProcedure,
+		svc.GetSpeciesHierarchy,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.SpeciesHierarchyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SpeciesHierarchyServiceSetSpeciesParentProcedure:
+			speciesHierarchyServiceSetSpeciesParentHandler.ServeHTTP(w, r)
+		case SpeciesHierarchyServiceGetSpeciesHierarchyProcedure:
+			speciesHierarchyServiceGetSpeciesHierarchyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSpeciesHierarchyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSpeciesHierarchyServiceHandler struct{}
+
+func (UnimplementedSpeciesHierarchyServiceHandler) SetSpeciesParent(context.Context, *connect_go.Request[v1alpha.SetSpeciesParentRequest]) (*connect_go.Response[v1alpha.SpeciesNode], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SpeciesHierarchyService.SetSpeciesParent is not implemented"))
+}
+
+func (UnimplementedSpeciesHierarchyServiceHandler) GetSpeciesHierarchy(context.Context, *connect_go.Request[v1alpha.GetSpeciesHierarchyRequest]) (*connect_go.Response[v1alpha.GetSpeciesHierarchyResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SpeciesHierarchyService.GetSpeciesHierarchy is not implemented"))
+}
+
+// SpeciesMaintenanceServiceClient is a client for the
+// tkd.treatment.v1alpha.SpeciesMaintenanceService service.
+type SpeciesMaintenanceServiceClient interface {
+	// MergeSpecies moves all references of the source species to the target
+	// species and deletes the source species afterwards.
+	MergeSpecies(context.Context, *connect_go.Request[v1alpha.MergeSpeciesRequest]) (*connect_go.Response[v1alpha.MergeSpeciesResponse], error)
+	// RenameSpecies renames a species and updates all references. The old name
+	// is kept as an alias for a configurable period and a SpeciesRenamedEvent
+	// is published.
+	RenameSpecies(context.Context, *connect_go.Request[v1alpha.RenameSpeciesRequest]) (*connect_go.Response[v1.Species], error)
+	// PreviewDeleteSpecies reports the impact of deleting a species with the
+	// given policy without changing anything.
+	PreviewDeleteSpecies(context.Context, *connect_go.Request[v1alpha.PreviewDeleteSpeciesRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error)
+	// DeleteSpeciesWithPolicy deletes a species using the given policy. The
+	// deletion and the chosen policy are recorded.
+	DeleteSpeciesWithPolicy(context.Context, *connect_go.Request[v1alpha.DeleteSpeciesWithPolicyRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error)
+	// ListSpeciesDeletions returns the recorded species deletions, the most
+	// recent first.
+	ListSpeciesDeletions(context.Context, *connect_go.Request[v1alpha.ListSpeciesDeletionsRequest]) (*connect_go.Response[v1alpha.ListSpeciesDeletionsResponse], error)
+}
+
+// NewSpeciesMaintenanceServiceClient constructs a client for the
+// tkd.treatment.v1alpha.SpeciesMaintenanceService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSpeciesMaintenanceServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) SpeciesMaintenanceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &speciesMaintenanceServiceClient{
+		mergeSpecies: connect_go.NewClient[v1alpha.MergeSpeciesRequest, v1alpha.MergeSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesMaintenanceServiceMergeSpeciesProcedure,
+			opts...,
+		),
+		renameSpecies: connect_go.NewClient[v1alpha.RenameSpeciesRequest, v1.Species](
+			httpClient,
+			baseURL+SpeciesMaintenanceServiceRenameSpeciesProcedure,
+			opts...,
+		),
+		previewDeleteSpecies: connect_go.NewClient[v1alpha.PreviewDeleteSpeciesRequest, v1alpha.DeleteSpeciesImpact](
+			httpClient,
+			baseURL+SpeciesMaintenanceServicePreviewDeleteSpeciesProcedure,
+			opts...,
+		),
+		deleteSpeciesWithPolicy: connect_go.NewClient[v1alpha.DeleteSpeciesWithPolicyRequest, v1alpha.DeleteSpeciesImpact](
+			httpClient,
+			baseURL+SpeciesMaintenanceServiceDeleteSpeciesWithPolicyProcedure,
+			opts...,
+		),
+		listSpeciesDeletions: connect_go.NewClient[v1alpha.ListSpeciesDeletionsRequest, v1alpha.ListSpeciesDeletionsResponse](
+			httpClient,
+			baseURL+SpeciesMaintenanceServiceListSpeciesDeletionsProcedure,
+			opts...,
+		),
+	}
+}
+
+// speciesMaintenanceServiceClient implements SpeciesMaintenanceServiceClient.
+type speciesMaintenanceServiceClient struct {
+	mergeSpecies            *connect_go.Client[v1alpha.MergeSpeciesRequest, v1alpha.MergeSpeciesResponse]
+	renameSpecies           *connect_go.Client[v1alpha.RenameSpeciesRequest, v1.Species]
+	previewDeleteSpecies    *connect_go.Client[v1alpha.PreviewDeleteSpeciesRequest, v1alpha.DeleteSpeciesImpact]
+	deleteSpeciesWithPolicy *connect_go.Client[v1alpha.DeleteSpeciesWithPolicyRequest, v1alpha.DeleteSpeciesImpact]
+	listSpeciesDeletions    *connect_go.Client[v1alpha.ListSpeciesDeletionsRequest, v1alpha.ListSpeciesDeletionsResponse]
+}
+
+// MergeSpecies calls tkd.treatment.v1alpha.SpeciesMaintenanceService.MergeSpecies.
+func (c *speciesMaintenanceServiceClient) MergeSpecies(ctx context.Context, req *connect_go.Request[v1alpha.MergeSpeciesRequest]) (*connect_go.Response[v1alpha.MergeSpeciesResponse], error) {
+	return c.mergeSpecies.CallUnary(ctx, req)
+}
+
+// RenameSpecies calls tkd.treatment.v1alpha.SpeciesMaintenanceService.RenameSpecies.
+func (c *speciesMaintenanceServiceClient) RenameSpecies(ctx context.Context, req *connect_go.Request[v1alpha.RenameSpeciesRequest]) (*connect_go.Response[v1.Species], error) {
+	return c.renameSpecies.CallUnary(ctx, req)
+}
+
+// PreviewDeleteSpecies calls tkd.treatment.v1alpha.SpeciesMaintenanceService.PreviewDeleteSpecies.
+func (c *speciesMaintenanceServiceClient) PreviewDeleteSpecies(ctx context.Context, req *connect_go.Request[v1alpha.PreviewDeleteSpeciesRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error) {
+	return c.previewDeleteSpecies.CallUnary(ctx, req)
+}
+
+// DeleteSpeciesWithPolicy calls
+// tkd.treatment.v1alpha.SpeciesMaintenanceService.DeleteSpeciesWithPolicy.
+func (c *speciesMaintenanceServiceClient) DeleteSpeciesWithPolicy(ctx context.Context, req *connect_go.Request[v1alpha.DeleteSpeciesWithPolicyRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error) {
+	return c.deleteSpeciesWithPolicy.CallUnary(ctx, req)
+}
+
+// ListSpeciesDeletions calls tkd.treatment.v1alpha.SpeciesMaintenanceService.ListSpeciesDeletions.
+func (c *speciesMaintenanceServiceClient) ListSpeciesDeletions(ctx context.Context, req *connect_go.Request[v1alpha.ListSpeciesDeletionsRequest]) (*connect_go.Response[v1alpha.ListSpeciesDeletionsResponse], error) {
+	return c.listSpeciesDeletions.CallUnary(ctx, req)
+}
+
+// SpeciesMaintenanceServiceHandler is an implementation of the
+// tkd.treatment.v1alpha.SpeciesMaintenanceService service.
+type SpeciesMaintenanceServiceHandler interface {
+	// MergeSpecies moves all references of the source species to the target
+	// species and deletes the source species afterwards.
+	MergeSpecies(context.Context, *connect_go.Request[v1alpha.MergeSpeciesRequest]) (*connect_go.Response[v1alpha.MergeSpeciesResponse], error)
+	// RenameSpecies renames a species and updates all references. The old name
+	// is kept as an alias for a configurable period and a SpeciesRenamedEvent
+	// is published.
+	RenameSpecies(context.Context, *connect_go.Request[v1alpha.RenameSpeciesRequest]) (*connect_go.Response[v1.Species], error)
+	// PreviewDeleteSpecies reports the impact of deleting a species with the
+	// given policy without changing anything.
+	PreviewDeleteSpecies(context.Context, *connect_go.Request[v1alpha.PreviewDeleteSpeciesRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error)
+	// DeleteSpeciesWithPolicy deletes a species using the given policy. The
+	// deletion and the chosen policy are recorded.
+	DeleteSpeciesWithPolicy(context.Context, *connect_go.Request[v1alpha.DeleteSpeciesWithPolicyRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error)
+	// ListSpeciesDeletions returns the recorded species deletions, the most
+	// recent first.
+	ListSpeciesDeletions(context.Context, *connect_go.Request[v1alpha.ListSpeciesDeletionsRequest]) (*connect_go.Response[v1alpha.ListSpeciesDeletionsResponse], error)
+}
+
+// NewSpeciesMaintenanceServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSpeciesMaintenanceServiceHandler(svc SpeciesMaintenanceServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	speciesMaintenanceServiceMergeSpeciesHandler := connect_go.NewUnaryHandler(
+		SpeciesMaintenanceServiceMergeSpeciesProcedure,
+		svc.MergeSpecies,
+		opts...,
+	)
+	speciesMaintenanceServiceRenameSpeciesHandler := connect_go.NewUnaryHandler(
+		SpeciesMaintenanceServiceRenameSpeciesProcedure,
+		svc.RenameSpecies,
+		opts...,
+	)
+	speciesMaintenanceServicePreviewDeleteSpeciesHandler := connect_go.NewUnaryHandler(
+		SpeciesMaintenanceServicePreviewDeleteSpeciesProcedure,
+		svc.PreviewDeleteSpecies,
+		opts...,
+	)
+	speciesMaintenanceServiceDeleteSpeciesWithPolicyHandler := connect_go.NewUnaryHandler(
+		SpeciesMaintenanceServiceDeleteSpeciesWithPolicyProcedure,
+		svc.DeleteSpeciesWithPolicy,
+		opts...,
+	)
+	speciesMaintenanceServiceListSpeciesDeletionsHandler := connect_go.NewUnaryHandler(
+		SpeciesMaintenanceServiceListSpeciesDeletionsProcedure,
+		svc.ListSpeciesDeletions,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.SpeciesMaintenanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SpeciesMaintenanceServiceMergeSpeciesProcedure:
+			speciesMaintenanceServiceMergeSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesMaintenanceServiceRenameSpeciesProcedure:
+			speciesMaintenanceServiceRenameSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesMaintenanceServicePreviewDeleteSpeciesProcedure:
+			speciesMaintenanceServicePreviewDeleteSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesMaintenanceServiceDeleteSpeciesWithPolicyProcedure:
+			speciesMaintenanceServiceDeleteSpeciesWithPolicyHandler.ServeHTTP(w, r)
+		case SpeciesMaintenanceServiceListSpeciesDeletionsProcedure:
+			speciesMaintenanceServiceListSpeciesDeletionsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSpeciesMaintenanceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSpeciesMaintenanceServiceHandler struct{}
+
+func (UnimplementedSpeciesMaintenanceServiceHandler) MergeSpecies(context.Context, *connect_go.Request[v1alpha.MergeSpeciesRequest]) (*connect_go.Response[v1alpha.MergeSpeciesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SpeciesMaintenanceService.MergeSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesMaintenanceServiceHandler) RenameSpecies(context.Context, *connect_go.Request[v1alpha.RenameSpeciesRequest]) (*connect_go.Response[v1.Species], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SpeciesMaintenanceService.RenameSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesMaintenanceServiceHandler) PreviewDeleteSpecies(context.Context, *connect_go.Request[v1alpha.PreviewDeleteSpeciesRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SpeciesMaintenanceService.PreviewDeleteSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesMaintenanceServiceHandler) DeleteSpeciesWithPolicy(context.Context, *connect_go.Request[v1alpha.DeleteSpeciesWithPolicyRequest]) (*connect_go.Response[v1alpha.DeleteSpeciesImpact], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SpeciesMaintenanceService.DeleteSpeciesWithPolicy is not implemented"))
+}
+
+func (UnimplementedSpeciesMaintenanceServiceHandler) ListSpeciesDeletions(context.Context, *connect_go.Request[v1alpha.ListSpeciesDeletionsRequest]) (*connect_go.Response[v1alpha.ListSpeciesDeletionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.SpeciesMaintenanceService.ListSpeciesDeletions is not implemented"))
+}
@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tkd/treatment/v1alpha/treatment.proto
+
+package treatmentv1alphaconnect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	v1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// TreatmentMaintenanceServiceName is the fully-qualified name of the TreatmentMaintenanceService
+	// service.
+	TreatmentMaintenanceServiceName = "tkd.treatment.v1alpha.TreatmentMaintenanceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TreatmentMaintenanceServiceRenameTreatmentProcedure is the fully-qualified name of the
+	// TreatmentMaintenanceService's RenameTreatment RPC.
+	TreatmentMaintenanceServiceRenameTreatmentProcedure = "/tkd.treatment.v1alpha.TreatmentMaintenanceService/RenameTreatment"
+	// TreatmentMaintenanceServiceBatchMutateTreatmentsProcedure is the fully-qualified name of the
+	// TreatmentMaintenanceService's BatchMutateTreatments RPC.
+	TreatmentMaintenanceServiceBatchMutateTreatmentsProcedure = "/tkd.treatment.v1alpha.TreatmentMaintenanceService/BatchMutateTreatments"
+	// TreatmentMaintenanceServiceReplaceReferencesProcedure is the fully-qualified name of the
+	// TreatmentMaintenanceService's ReplaceReferences RPC.
+	TreatmentMaintenanceServiceReplaceReferencesProcedure = "/tkd.treatment.v1alpha.TreatmentMaintenanceService/ReplaceReferences"
+	// TreatmentMaintenanceServiceCloneTreatmentProcedure is the fully-qualified name of the
+	// TreatmentMaintenanceService's CloneTreatment RPC.
+	TreatmentMaintenanceServiceCloneTreatmentProcedure = "/tkd.treatment.v1alpha.TreatmentMaintenanceService/CloneTreatment"
+)
+
+// TreatmentMaintenanceServiceClient is a client for the
+// tkd.treatment.v1alpha.TreatmentMaintenanceService service.
+type TreatmentMaintenanceServiceClient interface {
+	// RenameTreatment renames a treatment and updates all references. The old
+	// name is kept as an alias for a configurable period and a
+	// TreatmentRenamedEvent is published.
+	RenameTreatment(context.Context, *connect_go.Request[v1alpha.RenameTreatmentRequest]) (*connect_go.Response[v1.Treatment], error)
+	// BatchMutateTreatments applies multiple create, update and delete
+	// operations in a single transaction. All mutations are validated before
+	// any change is applied. If any mutation fails, no changes are applied
+	// and the error carries a BatchMutateTreatmentsResponse detail that
+	// holds the result of each mutation.
+	BatchMutateTreatments(context.Context, *connect_go.Request[v1alpha.BatchMutateTreatmentsRequest]) (*connect_go.Response[v1alpha.BatchMutateTreatmentsResponse], error)
+	// ReplaceReferences replaces or removes an employee or resource across
+	// all treatments in a single transaction. Preferred employees are kept
+	// a subset of the allowed employees.
+	ReplaceReferences(context.Context, *connect_go.Request[v1alpha.ReplaceReferencesRequest]) (*connect_go.Response[v1alpha.ReplaceReferencesResponse], error)
+	// CloneTreatment copies a treatment including its details under a new
+	// name. Prices are not copied.
+	CloneTreatment(context.Context, *connect_go.Request[v1alpha.CloneTreatmentRequest]) (*connect_go.Response[v1alpha.TreatmentWithDetails], error)
+}
+
+// NewTreatmentMaintenanceServiceClient constructs a client for the
+// tkd.treatment.v1alpha.TreatmentMaintenanceService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTreatmentMaintenanceServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) TreatmentMaintenanceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &treatmentMaintenanceServiceClient{
+		renameTreatment: connect_go.NewClient[v1alpha.RenameTreatmentRequest, v1.Treatment](
+			httpClient,
+			baseURL+TreatmentMaintenanceServiceRenameTreatmentProcedure,
+			opts...,
+		),
+		batchMutateTreatments: connect_go.NewClient[v1alpha.BatchMutateTreatmentsRequest, v1alpha.BatchMutateTreatmentsResponse](
+			httpClient,
+			baseURL+TreatmentMaintenanceServiceBatchMutateTreatmentsProcedure,
+			opts...,
+		),
+		replaceReferences: connect_go.NewClient[v1alpha.ReplaceReferencesRequest, v1alpha.ReplaceReferencesResponse](
+			httpClient,
+			baseURL+TreatmentMaintenanceServiceReplaceReferencesProcedure,
+			opts...,
+		),
+		cloneTreatment: connect_go.NewClient[v1alpha.CloneTreatmentRequest, v1alpha.TreatmentWithDetails](
+			httpClient,
+			baseURL+TreatmentMaintenanceServiceCloneTreatmentProcedure,
+			opts...,
+		),
+	}
+}
+
+// treatmentMaintenanceServiceClient implements TreatmentMaintenanceServiceClient.
+type treatmentMaintenanceServiceClient struct {
+	renameTreatment       *connect_go.Client[v1alpha.RenameTreatmentRequest, v1.Treatment]
+	batchMutateTreatments *connect_go.Client[v1alpha.BatchMutateTreatmentsRequest, v1alpha.BatchMutateTreatmentsResponse]
+	replaceReferences     *connect_go.Client[v1alpha.ReplaceReferencesRequest, v1alpha.ReplaceReferencesResponse]
+	cloneTreatment        *connect_go.Client[v1alpha.CloneTreatmentRequest, v1alpha.TreatmentWithDetails]
+}
+
+// RenameTreatment calls tkd.treatment.v1alpha.TreatmentMaintenanceService.RenameTreatment.
+func (c *treatmentMaintenanceServiceClient) RenameTreatment(ctx context.Context, req *connect_go.Request[v1alpha.RenameTreatmentRequest]) (*connect_go.Response[v1.Treatment], error) {
+	return c.renameTreatment.CallUnary(ctx, req)
+}
+
+// BatchMutateTreatments calls
+// tkd.treatment.v1alpha.TreatmentMaintenanceService.BatchMutateTreatments.
+func (c *treatmentMaintenanceServiceClient) BatchMutateTreatments(ctx context.Context, req *connect_go.Request[v1alpha.BatchMutateTreatmentsRequest]) (*connect_go.Response[v1alpha.BatchMutateTreatmentsResponse], error) {
+	return c.batchMutateTreatments.CallUnary(ctx, req)
+}
+
+// ReplaceReferences calls tkd.treatment.v1alpha.TreatmentMaintenanceService.ReplaceReferences.
+func (c *treatmentMaintenanceServiceClient) ReplaceReferences(ctx context.Context, req *connect_go.Request[v1alpha.ReplaceReferencesRequest]) (*connect_go.Response[v1alpha.ReplaceReferencesResponse], error) {
+	return c.replaceReferences.CallUnary(ctx, req)
+}
+
+// CloneTreatment calls tkd.treatment.v1alpha.TreatmentMaintenanceService.CloneTreatment.
+func (c *treatmentMaintenanceServiceClient) CloneTreatment(ctx context.Context, req *connect_go.Request[v1alpha.CloneTreatmentRequest]) (*connect_go.Response[v1alpha.TreatmentWithDetails], error) {
+	return c.cloneTreatment.CallUnary(ctx, req)
+}
+
+// TreatmentMaintenanceServiceHandler is an implementation of the
+// tkd.treatment.v1alpha.TreatmentMaintenanceService service.
+type TreatmentMaintenanceServiceHandler interface {
+	// RenameTreatment renames a treatment and updates all references. The old
+	// name is kept as an alias for a configurable period and a
+	// TreatmentRenamedEvent is published.
+	RenameTreatment(context.Context, *connect_go.Request[v1alpha.RenameTreatmentRequest]) (*connect_go.Response[v1.Treatment], error)
+	// BatchMutateTreatments applies multiple create, update and delete
+	// operations in a single transaction. All mutations are validated before
+	// any change is applied. If any mutation fails, no changes are applied
+	// and the error carries a BatchMutateTreatmentsResponse detail that
+	// holds the result of each mutation.
+	BatchMutateTreatments(context.Context, *connect_go.Request[v1alpha.BatchMutateTreatmentsRequest]) (*connect_go.Response[v1alpha.BatchMutateTreatmentsResponse], error)
+	// ReplaceReferences replaces or removes an employee or resource across
+	// all treatments in a single transaction. Preferred employees are kept
+	// a subset of the allowed employees.
+	ReplaceReferences(context.Context, *connect_go.Request[v1alpha.ReplaceReferencesRequest]) (*connect_go.Response[v1alpha.ReplaceReferencesResponse], error)
+	// CloneTreatment copies a treatment including its details under a new
+	// name. Prices are not copied.
+	CloneTreatment(context.Context, *connect_go.Request[v1alpha.CloneTreatmentRequest]) (*connect_go.Response[v1alpha.TreatmentWithDetails], error)
+}
+
+// NewTreatmentMaintenanceServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTreatmentMaintenanceServiceHandler(svc TreatmentMaintenanceServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	treatmentMaintenanceServiceRenameTreatmentHandler := connect_go.NewUnaryHandler(
+		TreatmentMaintenanceServiceRenameTreatmentProcedure,
+		svc.RenameTreatment,
+		opts...,
+	)
+	treatmentMaintenanceServiceBatchMutateTreatmentsHandler := connect_go.NewUnaryHandler(
+		TreatmentMaintenanceServiceBatchMutateTreatmentsProcedure,
+		svc.BatchMutateTreatments,
+		opts...,
+	)
+	treatmentMaintenanceServiceReplaceReferencesHandler := connect_go.NewUnaryHandler(
+		TreatmentMaintenanceServiceReplaceReferencesProcedure,
+		svc.ReplaceReferences,
+		opts...,
+	)
+	treatmentMaintenanceServiceCloneTreatmentHandler := connect_go.NewUnaryHandler(
+		TreatmentMaintenanceServiceCloneTreatmentProcedure,
+		svc.CloneTreatment,
+		opts...,
+	)
+	return "/tkd.treatment.v1alpha.TreatmentMaintenanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TreatmentMaintenanceServiceRenameTreatmentProcedure:
+			treatmentMaintenanceServiceRenameTreatmentHandler.ServeHTTP(w, r)
+		case TreatmentMaintenanceServiceBatchMutateTreatmentsProcedure:
+			treatmentMaintenanceServiceBatchMutateTreatmentsHandler.ServeHTTP(w, r)
+		case TreatmentMaintenanceServiceReplaceReferencesProcedure:
+			treatmentMaintenanceServiceReplaceReferencesHandler.ServeHTTP(w, r)
+		case TreatmentMaintenanceServiceCloneTreatmentProcedure:
+			treatmentMaintenanceServiceCloneTreatmentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTreatmentMaintenanceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTreatmentMaintenanceServiceHandler struct{}
+
+func (UnimplementedTreatmentMaintenanceServiceHandler) RenameTreatment(context.Context, *connect_go.Request[v1alpha.RenameTreatmentRequest]) (*connect_go.Response[v1.Treatment], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentMaintenanceService.RenameTreatment is not implemented"))
+}
+
+func (UnimplementedTreatmentMaintenanceServiceHandler) BatchMutateTreatments(context.Context, *connect_go.Request[v1alpha.BatchMutateTreatmentsRequest]) (*connect_go.Response[v1alpha.BatchMutateTreatmentsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentMaintenanceService.BatchMutateTreatments is not implemented"))
+}
+
+func (UnimplementedTreatmentMaintenanceServiceHandler) ReplaceReferences(context.Context, *connect_go.Request[v1alpha.ReplaceReferencesRequest]) (*connect_go.Response[v1alpha.ReplaceReferencesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentMaintenanceService.ReplaceReferences is not implemented"))
+}
+
+func (UnimplementedTreatmentMaintenanceServiceHandler) CloneTreatment(context.Context, *connect_go.Request[v1alpha.CloneTreatmentRequest]) (*connect_go.Response[v1alpha.TreatmentWithDetails], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tkd.treatment.v1alpha.TreatmentMaintenanceService.CloneTreatment is not implemented"))
+}
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"context"
 	"time"
 
 	"github.com/tierklinik-dobersberg/apis/pkg/service"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/tracing"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type Config struct {
@@ -12,4 +16,86 @@ type Config struct {
 
 	DefaultInitialTimeRequirement    time.Duration `env:"INITIAL_TIME_REQUIREMENT,default=15m"`
 	DefaultAdditionalTimeRequirement time.Duration `env:"ADDITIONAL_TIME_REQUIREMENT,default=10m"`
+
+	// FuzzySpeciesDetection enables typo-tolerant matching of species
+	// match words in DetectSpecies.
+	FuzzySpeciesDetection bool `env:"FUZZY_SPECIES_DETECTION,default=false"`
+
+	// FuzzyMaxDistance is the maximum edit distance tolerated for fuzzy
+	// matches. The effective tolerance is further limited by the length of
+	// the match word (one edit per four characters).
+	FuzzyMaxDistance int `env:"FUZZY_MAX_DISTANCE,default=2"`
+
+	// WarnMatchRuleConflicts enables a background analysis of the match
+	// rules after changes to species and treatments. New match rule
+	// conflicts are logged as warnings.
+	WarnMatchRuleConflicts bool `env:"WARN_MATCH_RULE_CONFLICTS,default=false"`
+
+	// DetectionFixtureMode defines how UpdateSpecies and UpdateTreatment
+	// handle changes that cause previously passing detection fixtures
+	// to fail. See the FixtureMode constants for possible values.
+	DetectionFixtureMode string `env:"DETECTION_FIXTURE_MODE,default=reject"`
+
+	// CatalogCacheTTL defines how long the public self-booking catalog
+	// is cached.
+	CatalogCacheTTL time.Duration `env:"CATALOG_CACHE_TTL,default=1m"`
+
+	// CatalogRateLimit is the number of requests per minute a single client
+	// may send to the public self-booking catalog.
+	CatalogRateLimit float64 `env:"CATALOG_RATE_LIMIT,default=60"`
+
+	// CatalogRateLimitBurst is the maximum burst size for CatalogRateLimit.
+	CatalogRateLimitBurst int `env:"CATALOG_RATE_LIMIT_BURST,default=10"`
+
+	// RenameAliasTTL defines how long the old name of a renamed species or
+	// treatment is still resolved by GetTreatment, GetSpecies and ListSpecies.
+	RenameAliasTTL time.Duration `env:"RENAME_ALIAS_TTL,default=720h"`
+
+	// MetricsListen is the address of the Prometheus metrics endpoint, for
+	// example ":9090". The endpoint is not authenticated, so metrics are
+	// disabled by default and should only be served on an internal address.
+	MetricsListen string `env:"METRICS_LISTEN"`
+
+	// MetricsPath is the HTTP path of the Prometheus metrics endpoint.
+	MetricsPath string `env:"METRICS_PATH,default=/metrics"`
+
+	// TracingExporter defines where traces are exported to. Supported values
+	// are "none", "otlp" and "stdout".
+	TracingExporter string `env:"TRACING_EXPORTER,default=none"`
+
+	// TracingOTLPEndpoint is the host and port of the OTLP/HTTP collector.
+	// Defaults to OTEL_EXPORTER_OTLP_ENDPOINT if empty.
+	TracingOTLPEndpoint string `env:"TRACING_OTLP_ENDPOINT"`
+
+	// TracingOTLPInsecure disables TLS for the OTLP exporter.
+	TracingOTLPInsecure bool `env:"TRACING_OTLP_INSECURE,default=false"`
+
+	// TracingSampleRatio is the ratio of requests that are traced unless the
+	// caller already decided to sample the request.
+	TracingSampleRatio float64 `env:"TRACING_SAMPLE_RATIO,default=1"`
+}
+
+// ConfigureDatabase connects to MongoDB and instruments all database
+// commands for tracing.
+func (c Config) ConfigureDatabase(ctx context.Context) (*mongo.Database, error) {
+	cli, err := mongo.Connect(ctx, options.Client().
+		ApplyURI(c.MongoURL).
+		SetMonitor(tracing.NewCommandMonitor()))
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.Database(c.Database), nil
 }
+
+const (
+	// FixtureModeReject rejects changes that cause detection fixture regressions.
+	FixtureModeReject = "reject"
+
+	// FixtureModeWarn accepts the change but reports all regressions using
+	// the Detection-Fixture-Regression response header.
+	FixtureModeWarn = "warn"
+
+	// FixtureModeOff disables detection fixture evaluation on updates.
+	FixtureModeOff = "off"
+)
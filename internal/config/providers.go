@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/tierklinik-dobersberg/apis/pkg/discovery/wellknown"
@@ -20,7 +21,21 @@ type Providers struct {
 
 type Instance = service.Instance[Config, *mongo.Database]
 
+// ErrInvalidConfig is returned by NewProviders if the configuration is
+// invalid.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
 func NewProviders(ctx context.Context, i *Instance) (*Providers, error) {
+	switch i.Config.DetectionFixtureMode {
+	case FixtureModeReject, FixtureModeWarn, FixtureModeOff:
+	default:
+		return nil, fmt.Errorf("%w: invalid value for DETECTION_FIXTURE_MODE: %q", ErrInvalidConfig, i.Config.DetectionFixtureMode)
+	}
+
+	if err := i.Database.Client().Ping(ctx, nil); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
 	repo, err := repo.NewRepositoryWithClient(ctx, i.Database, i.Config.DefaultInitialTimeRequirement, i.Config.DefaultAdditionalTimeRequirement)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create repository: %w", err)
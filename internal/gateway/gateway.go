@@ -0,0 +1,743 @@
+// Package gateway exposes the species and treatment operations as REST/JSON
+// resources and serves an OpenAPI 3 description of them.
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	commonv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1/treatmentv1connect"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha/treatmentv1alphaconnect"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/validation"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maxBodySize is the maximum size of request bodies.
+const maxBodySize = 1 << 20
+
+// OpenAPIPath is the path of the OpenAPI document.
+const OpenAPIPath = "/v1/openapi.json"
+
+// Gateway translates REST requests into calls of the species and treatment
+// services.
+type Gateway struct {
+	species    treatmentv1connect.SpeciesServiceClient
+	treatments treatmentv1connect.TreatmentServiceClient
+	details    treatmentv1alphaconnect.TreatmentDetailsServiceClient
+}
+
+// New returns a new gateway. Both the service implementation and connect
+// clients can be used. Use clients created by NewInProcessClient so requests
+// pass the interceptors (authentication, validation, ...) of the handlers.
+func New(species treatmentv1connect.SpeciesServiceClient, treatments treatmentv1connect.TreatmentServiceClient, details treatmentv1alphaconnect.TreatmentDetailsServiceClient) *Gateway {
+	return &Gateway{
+		species:    species,
+		treatments: treatments,
+		details:    details,
+	}
+}
+
+// param describes a path or query parameter.
+type param struct {
+	Name        string
+	In          string
+	Description string
+	Repeated    bool
+
+	// Type is the JSON schema type of the parameter and defaults to
+	// "string".
+	Type string
+
+	// Enum, if set, restricts the parameter to the names of the enum values.
+	Enum protoreflect.EnumDescriptor
+}
+
+// route describes a REST operation.
+type route struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Params      []param
+
+	// Body is the request body message, if any.
+	Body protoreflect.MessageDescriptor
+
+	// Response is the response message or nil for 204 No Content.
+	Response protoreflect.MessageDescriptor
+
+	// Status is the HTTP status code of successful responses.
+	Status int
+
+	Handle func(r *http.Request) (proto.Message, error)
+}
+
+var (
+	nameParam = param{Name: "name", In: "path", Description: "The unique name of the resource."}
+	maskParam = param{Name: "update_mask", In: "query", Description: "Comma separated list of fields to update. Defaults to all fields present in the request body. Requests without any field to update are rejected."}
+)
+
+func (g *Gateway) routes() []route {
+	species := (&treatmentv1.Species{}).ProtoReflect().Descriptor()
+	treatment := (&treatmentv1.Treatment{}).ProtoReflect().Descriptor()
+	patient := (&treatmentv1alpha.PatientAttributes{}).ProtoReflect().Descriptor().Fields()
+
+	return []route{
+		{
+			Method:      http.MethodGet,
+			Path:        "/v1/species",
+			OperationID: "listSpecies",
+			Summary:     "List species, optionally filtered by name.",
+			Params:      []param{{Name: "name", In: "query", Description: "Only return species with the given names.", Repeated: true}},
+			Response:    (&treatmentv1.ListSpeciesResponse{}).ProtoReflect().Descriptor(),
+			Status:      http.StatusOK,
+			Handle:      g.listSpecies,
+		},
+		{
+			Method:      http.MethodPost,
+			Path:        "/v1/species",
+			OperationID: "createSpecies",
+			Summary:     "Create a new species.",
+			Body:        species,
+			Response:    species,
+			Status:      http.StatusCreated,
+			Handle:      g.createSpecies,
+		},
+		{
+			Method:      http.MethodGet,
+			Path:        "/v1/species:detect",
+			OperationID: "detectSpecies",
+			Summary:     "Detect species from free-text values.",
+			Params:      []param{{Name: "value", In: "query", Description: "Free-text values to detect species from.", Repeated: true}},
+			Response:    (&treatmentv1.ListSpeciesResponse{}).ProtoReflect().Descriptor(),
+			Status:      http.StatusOK,
+			Handle:      g.detectSpecies,
+		},
+		{
+			Method:      http.MethodGet,
+			Path:        "/v1/species/{name}",
+			OperationID: "getSpecies",
+			Summary:     "Get a species by name.",
+			Params:      []param{nameParam},
+			Response:    species,
+			Status:      http.StatusOK,
+			Handle:      g.getSpecies,
+		},
+		{
+			Method:      http.MethodPatch,
+			Path:        "/v1/species/{name}",
+			OperationID: "updateSpecies",
+			Summary:     "Update a species. Only the fields present in the request body are updated.",
+			Params:      []param{nameParam, maskParam},
+			Body:        species,
+			Response:    species,
+			Status:      http.StatusOK,
+			Handle:      g.updateSpecies,
+		},
+		{
+			Method:      http.MethodDelete,
+			Path:        "/v1/species/{name}",
+			OperationID: "deleteSpecies",
+			Summary:     "Delete a species.",
+			Params:      []param{nameParam},
+			Status:      http.StatusNoContent,
+			Handle:      g.deleteSpecies,
+		},
+		{
+			Method:      http.MethodGet,
+			Path:        "/v1/treatments",
+			OperationID: "listTreatments",
+			Summary:     "List treatments, optionally filtered by species and display name.",
+			Params: []param{
+				{Name: "species", In: "query", Description: "Only return treatments available for the species."},
+				{Name: "display_name", In: "query", Description: "Only return treatments whose display name contains the value."},
+			},
+			Response: (&treatmentv1.ListTreatmentsResponse{}).ProtoReflect().Descriptor(),
+			Status:   http.StatusOK,
+			Handle:   g.listTreatments,
+		},
+		{
+			Method:      http.MethodGet,
+			Path:        "/v1/treatments:withDetails",
+			OperationID: "listTreatmentsWithDetails",
+			Summary:     "List treatments including their details, optionally filtered by pre-op requirements and patient eligibility.",
+			Params: []param{
+				{Name: "species", In: "query", Description: "Only return treatments available for the species."},
+				{Name: "display_name", In: "query", Description: "Only return treatments whose display name contains the value."},
+				{Name: "anesthesia", In: "query", Description: "Only return treatments that do or do not require anesthesia.", Enum: treatmentv1alpha.AnesthesiaFilter(0).Descriptor()},
+				{Name: "start_time", In: "query", Description: "Only return treatments that may be started at the given time of day (HH:MM)."},
+				{Name: "patient.sex", In: "query", Description: "Only return treatments a patient of the given sex is eligible for.", Enum: patient.ByName("sex").Enum()},
+				{Name: "patient.castration_status", In: "query", Description: "Only return treatments a patient with the given castration status is eligible for. Ignored unless the species requests the castration status.", Enum: patient.ByName("castration_status").Enum()},
+				{Name: "include_templates", In: "query", Description: "Include template treatments.", Type: "boolean"},
+			},
+			Response: (&treatmentv1alpha.ListTreatmentsWithDetailsResponse{}).ProtoReflect().Descriptor(),
+			Status:   http.StatusOK,
+			Handle:   g.listTreatmentsWithDetails,
+		},
+		{
+			Method:      http.MethodPost,
+			Path:        "/v1/treatments",
+			OperationID: "createTreatment",
+			Summary:     "Create a new treatment.",
+			Body:        treatment,
+			Response:    treatment,
+			Status:      http.StatusCreated,
+			Handle:      g.createTreatment,
+		},
+		{
+			Method:      http.MethodGet,
+			Path:        "/v1/treatments/{name}",
+			OperationID: "getTreatment",
+			Summary:     "Get a treatment by name.",
+			Params:      []param{nameParam},
+			Response:    treatment,
+			Status:      http.StatusOK,
+			Handle:      g.getTreatment,
+		},
+		{
+			Method:      http.MethodPatch,
+			Path:        "/v1/treatments/{name}",
+			OperationID: "updateTreatment",
+			Summary:     "Update a treatment. Only the fields present in the request body are updated.",
+			Params:      []param{nameParam, maskParam},
+			Body:        treatment,
+			Response:    treatment,
+			Status:      http.StatusOK,
+			Handle:      g.updateTreatment,
+		},
+		{
+			Method:      http.MethodDelete,
+			Path:        "/v1/treatments/{name}",
+			OperationID: "deleteTreatment",
+			Summary:     "Delete a treatment.",
+			Params:      []param{nameParam},
+			Status:      http.StatusNoContent,
+			Handle:      g.deleteTreatment,
+		},
+	}
+}
+
+// Handler returns the HTTP handler serving all REST resources and the
+// OpenAPI document.
+func (g *Gateway) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	routes := g.routes()
+	for _, rt := range routes {
+		mux.Handle(rt.Method+" "+rt.Path, g.serve(rt))
+	}
+
+	doc := openAPI(routes)
+	mux.HandleFunc("GET "+OpenAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, doc)
+	})
+
+	return mux
+}
+
+func (g *Gateway) serve(rt route) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, err := rt.Handle(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if rt.Response == nil {
+			w.WriteHeader(rt.Status)
+			return
+		}
+
+		blob, err := protojson.Marshal(res)
+		if err != nil {
+			writeError(w, fmt.Errorf("failed to marshal response: %w", err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(rt.Status)
+		_, _ = w.Write(blob)
+	})
+}
+
+// newRequest returns a new connect request for msg that carries the headers
+// of the REST request, e.g. to authenticate the caller.
+func newRequest[T any](r *http.Request, msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+
+	for key, values := range r.Header {
+		switch strings.ToLower(key) {
+		case "content-type", "content-length", "content-encoding", "accept", "accept-encoding", "connection", "te":
+			continue
+		}
+
+		if strings.HasPrefix(strings.ToLower(key), "connect-") || strings.HasPrefix(strings.ToLower(key), "grpc-") {
+			continue
+		}
+
+		for _, v := range values {
+			req.Header().Add(key, v)
+		}
+	}
+
+	return req
+}
+
+// readBody decodes the JSON request body into msg and returns the names of
+// all top-level fields that are present in the body. The name and update
+// mask fields are never reported as they are not part of the update.
+func readBody(r *http.Request, msg proto.Message) ([]string, error) {
+	blob, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	if err := protojson.Unmarshal(blob, msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid request body: %w", err))
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(blob, &keys); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid request body: %w", err))
+	}
+
+	fields := msg.ProtoReflect().Descriptor().Fields()
+
+	var paths []string
+	for key := range keys {
+		fd := fields.ByJSONName(key)
+		if fd == nil {
+			fd = fields.ByTextName(key)
+		}
+
+		if fd == nil {
+			continue
+		}
+
+		switch fd.Name() {
+		case "name", "update_mask":
+			continue
+		}
+
+		paths = append(paths, string(fd.Name()))
+	}
+
+	// keep the update mask independent of the key order
+	slices.Sort(paths)
+
+	return paths, nil
+}
+
+// updateMask returns the update_mask query parameter or the fields present
+// in the request body. Since an empty mask updates all fields, an error is
+// returned if there are no fields to update.
+func updateMask(r *http.Request, present []string) (*fieldmaskpb.FieldMask, error) {
+	if m := r.URL.Query().Get("update_mask"); m != "" {
+		return &fieldmaskpb.FieldMask{Paths: strings.Split(m, ",")}, nil
+	}
+
+	if len(present) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("request body does not contain any fields to update"))
+	}
+
+	return &fieldmaskpb.FieldMask{Paths: present}, nil
+}
+
+func (g *Gateway) listSpecies(r *http.Request) (proto.Message, error) {
+	res, err := g.species.ListSpecies(r.Context(), newRequest(r, &treatmentv1.ListSpeciesRequest{
+		Names: r.URL.Query()["name"],
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) getSpecies(r *http.Request) (proto.Message, error) {
+	name := r.PathValue("name")
+
+	res, err := g.species.ListSpecies(r.Context(), newRequest(r, &treatmentv1.ListSpeciesRequest{
+		Names: []string{name},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Msg.Species) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("species %q not found", name))
+	}
+
+	return res.Msg.Species[0], nil
+}
+
+func (g *Gateway) detectSpecies(r *http.Request) (proto.Message, error) {
+	res, err := g.species.DetectSpecies(r.Context(), newRequest(r, &treatmentv1.DetectSpeciesRequest{
+		Values: r.URL.Query()["value"],
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) createSpecies(r *http.Request) (proto.Message, error) {
+	s := new(treatmentv1.Species)
+	if _, err := readBody(r, s); err != nil {
+		return nil, err
+	}
+
+	res, err := g.species.CreateSpecies(r.Context(), newRequest(r, s))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) updateSpecies(r *http.Request) (proto.Message, error) {
+	s := new(treatmentv1.Species)
+
+	present, err := readBody(r, s)
+	if err != nil {
+		return nil, err
+	}
+
+	mask, err := updateMask(r, present)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := g.species.UpdateSpecies(r.Context(), newRequest(r, &treatmentv1.UpdateSpeciesRequest{
+		Name:       r.PathValue("name"),
+		Species:    s,
+		UpdateMask: mask,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) deleteSpecies(r *http.Request) (proto.Message, error) {
+	_, err := g.species.DeleteSpecies(r.Context(), newRequest(r, &treatmentv1.DeleteSpeciesRequest{
+		Name: r.PathValue("name"),
+	}))
+
+	return nil, err
+}
+
+func (g *Gateway) listTreatments(r *http.Request) (proto.Message, error) {
+	res, err := g.treatments.ListTreatments(r.Context(), newRequest(r, &treatmentv1.ListTreatmentsRequest{
+		Species:           r.URL.Query().Get("species"),
+		DisplayNameSearch: r.URL.Query().Get("display_name"),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) listTreatmentsWithDetails(r *http.Request) (proto.Message, error) {
+	req, err := listTreatmentsWithDetailsRequest(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := g.details.ListTreatmentsWithDetails(r.Context(), newRequest(r, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+// listTreatmentsWithDetailsRequest decodes the query parameters of
+// listTreatmentsWithDetails. Enum parameters accept the names of the enum
+// values, e.g. ANESTHESIA_FILTER_REQUIRED.
+func listTreatmentsWithDetailsRequest(q url.Values) (*treatmentv1alpha.ListTreatmentsWithDetailsRequest, error) {
+	var (
+		v   = new(validation.Violations)
+		req = &treatmentv1alpha.ListTreatmentsWithDetailsRequest{
+			Species:           q.Get("species"),
+			DisplayNameSearch: q.Get("display_name"),
+			Anesthesia:        treatmentv1alpha.AnesthesiaFilter(queryEnum(v, q, "anesthesia", treatmentv1alpha.AnesthesiaFilter(0).Descriptor())),
+		}
+		sex        = treatmentv1alpha.Sex(queryEnum(v, q, "patient.sex", treatmentv1alpha.Sex(0).Descriptor()))
+		castration = treatmentv1alpha.CastrationStatus(queryEnum(v, q, "patient.castration_status", treatmentv1alpha.CastrationStatus(0).Descriptor()))
+	)
+
+	if sex != treatmentv1alpha.Sex_SEX_UNSPECIFIED || castration != treatmentv1alpha.CastrationStatus_CASTRATION_STATUS_UNSPECIFIED {
+		req.Patient = &treatmentv1alpha.PatientAttributes{
+			Sex:              sex,
+			CastrationStatus: castration,
+		}
+	}
+
+	if value := q.Get("start_time"); value != "" {
+		t, err := time.Parse("15:04", value)
+		if err != nil {
+			v.Add("start_time", "invalid time of day %q, expected HH:MM", value)
+		} else {
+			req.StartTime = &commonv1.DayTime{
+				Hour:   int32(t.Hour()),
+				Minute: int32(t.Minute()),
+			}
+		}
+	}
+
+	if value := q.Get("include_templates"); value != "" {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			v.Add("include_templates", "invalid boolean %q", value)
+		}
+
+		req.IncludeTemplates = b
+	}
+
+	return req, v.Err()
+}
+
+// queryEnum returns the number of the enum value named by the query
+// parameter key or zero if the parameter is not set.
+func queryEnum(v *validation.Violations, q url.Values, key string, ed protoreflect.EnumDescriptor) protoreflect.EnumNumber {
+	value := q.Get(key)
+	if value == "" {
+		return 0
+	}
+
+	ev := ed.Values().ByName(protoreflect.Name(value))
+	if ev == nil {
+		v.Add(key, "invalid value %q, expected one of %s", value, strings.Join(enumNames(ed), ", "))
+		return 0
+	}
+
+	return ev.Number()
+}
+
+func (g *Gateway) getTreatment(r *http.Request) (proto.Message, error) {
+	res, err := g.treatments.GetTreatment(r.Context(), newRequest(r, &treatmentv1.GetTreatmentRequest{
+		Name: r.PathValue("name"),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) createTreatment(r *http.Request) (proto.Message, error) {
+	t := new(treatmentv1.Treatment)
+	if _, err := readBody(r, t); err != nil {
+		return nil, err
+	}
+
+	res, err := g.treatments.CreateTreatment(r.Context(), newRequest(r, t))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) updateTreatment(r *http.Request) (proto.Message, error) {
+	t := new(treatmentv1.Treatment)
+
+	present, err := readBody(r, t)
+	if err != nil {
+		return nil, err
+	}
+
+	mask, err := updateMask(r, present)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := g.treatments.UpdateTreatment(r.Context(), newRequest(r, &treatmentv1.UpdateTreatmentRequest{
+		Name:                      r.PathValue("name"),
+		DisplayName:               t.DisplayName,
+		HelpText:                  t.HelpText,
+		Species:                   t.Species,
+		AllowSelfBooking:          t.AllowSelfBooking,
+		InitialTimeRequirement:    t.InitialTimeRequirement,
+		AdditionalTimeRequirement: t.AdditionalTimeRequirement,
+		AllowedEmployees:          t.AllowedEmployees,
+		MatchEventText:            t.MatchEventText,
+		PreferredEmployees:        t.PreferredEmployees,
+		Resources:                 t.Resources,
+		UpdateMask:                mask,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+func (g *Gateway) deleteTreatment(r *http.Request) (proto.Message, error) {
+	_, err := g.treatments.DeleteTreatment(r.Context(), newRequest(r, &treatmentv1.DeleteTreatmentRequest{
+		Name: r.PathValue("name"),
+	}))
+
+	return nil, err
+}
+
+// httpStatus maps connect error codes to HTTP status codes.
+var httpStatus = map[connect.Code]int{
+	connect.CodeCanceled:           499,
+	connect.CodeUnknown:            http.StatusInternalServerError,
+	connect.CodeInvalidArgument:    http.StatusBadRequest,
+	connect.CodeDeadlineExceeded:   http.StatusGatewayTimeout,
+	connect.CodeNotFound:           http.StatusNotFound,
+	connect.CodeAlreadyExists:      http.StatusConflict,
+	connect.CodePermissionDenied:   http.StatusForbidden,
+	connect.CodeResourceExhausted:  http.StatusTooManyRequests,
+	connect.CodeFailedPrecondition: http.StatusPreconditionFailed,
+	connect.CodeAborted:            http.StatusConflict,
+	connect.CodeOutOfRange:         http.StatusBadRequest,
+	connect.CodeUnimplemented:      http.StatusNotImplemented,
+	connect.CodeInternal:           http.StatusInternalServerError,
+	connect.CodeUnavailable:        http.StatusServiceUnavailable,
+	connect.CodeDataLoss:           http.StatusInternalServerError,
+	connect.CodeUnauthenticated:    http.StatusUnauthorized,
+}
+
+// errorBody is the JSON representation of errors.
+type errorBody struct {
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	body := errorBody{
+		Code:    connect.CodeUnknown.String(),
+		Message: err.Error(),
+	}
+
+	status := http.StatusInternalServerError
+
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		body.Code = cerr.Code().String()
+		body.Message = cerr.Message()
+		status = httpStatus[cerr.Code()]
+
+		for _, d := range cerr.Details() {
+			msg, err := d.Value()
+			if err != nil {
+				continue
+			}
+
+			// wrap the detail in an Any so clients can tell details apart
+			a, err := anypb.New(msg)
+			if err != nil {
+				continue
+			}
+
+			if blob, err := protojson.Marshal(a); err == nil {
+				body.Details = append(body.Details, blob)
+			}
+		}
+	}
+
+	writeJSON(w, status, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// NewInProcessClient returns an HTTP client that serves all requests using
+// h without a network round-trip.
+func NewInProcessClient(h http.Handler) *http.Client {
+	return &http.Client{
+		Transport: inProcessTransport{handler: h},
+	}
+}
+
+// InProcessBaseURL is the base URL for connect clients using
+// NewInProcessClient.
+const InProcessBaseURL = "http://in-process"
+
+// inProcessTransport is a http.RoundTripper that passes requests directly
+// to a handler.
+type inProcessTransport struct {
+	handler http.Handler
+}
+
+func (t inProcessTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	req := r.Clone(r.Context())
+	req.RequestURI = r.URL.RequestURI()
+	if req.Body == nil {
+		req.Body = http.NoBody
+	}
+
+	w := &responseWriter{
+		header: make(http.Header),
+	}
+	t.handler.ServeHTTP(w, req)
+
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", w.status, http.StatusText(w.status)),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(&w.body),
+		ContentLength: int64(w.body.Len()),
+		Request:       r,
+	}, nil
+}
+
+// responseWriter buffers the response of an in-process request.
+type responseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	// like net/http, only the first call has an effect
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+
+	return w.body.Write(p)
+}
@@ -0,0 +1,384 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	commonv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1/treatmentv1connect"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha/treatmentv1alphaconnect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// fakeService records the requests it receives and fails all of them with
+// err, if set.
+type fakeService struct {
+	treatmentv1connect.UnimplementedSpeciesServiceHandler
+	treatmentv1connect.UnimplementedTreatmentServiceHandler
+	treatmentv1alphaconnect.UnimplementedTreatmentDetailsServiceHandler
+
+	err      error
+	requests []proto.Message
+	header   http.Header
+}
+
+func record[Req, Res any](f *fakeService, req *connect.Request[Req], res *Res) (*connect.Response[Res], error) {
+	f.requests = append(f.requests, any(req.Msg).(proto.Message))
+	f.header = req.Header()
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (f *fakeService) CreateSpecies(_ context.Context, req *connect.Request[treatmentv1.Species]) (*connect.Response[treatmentv1.Species], error) {
+	return record(f, req, req.Msg)
+}
+
+func (f *fakeService) ListSpecies(_ context.Context, req *connect.Request[treatmentv1.ListSpeciesRequest]) (*connect.Response[treatmentv1.ListSpeciesResponse], error) {
+	res := new(treatmentv1.ListSpeciesResponse)
+	for _, name := range req.Msg.Names {
+		res.Species = append(res.Species, &treatmentv1.Species{Name: name})
+	}
+
+	return record(f, req, res)
+}
+
+func (f *fakeService) UpdateSpecies(_ context.Context, req *connect.Request[treatmentv1.UpdateSpeciesRequest]) (*connect.Response[treatmentv1.Species], error) {
+	return record(f, req, &treatmentv1.Species{Name: req.Msg.Name})
+}
+
+func (f *fakeService) DeleteSpecies(_ context.Context, req *connect.Request[treatmentv1.DeleteSpeciesRequest]) (*connect.Response[emptypb.Empty], error) {
+	return record(f, req, &emptypb.Empty{})
+}
+
+func (f *fakeService) DetectSpecies(_ context.Context, req *connect.Request[treatmentv1.DetectSpeciesRequest]) (*connect.Response[treatmentv1.ListSpeciesResponse], error) {
+	return record(f, req, &treatmentv1.ListSpeciesResponse{})
+}
+
+func (f *fakeService) GetTreatment(_ context.Context, req *connect.Request[treatmentv1.GetTreatmentRequest]) (*connect.Response[treatmentv1.Treatment], error) {
+	return record(f, req, &treatmentv1.Treatment{Name: req.Msg.Name})
+}
+
+func (f *fakeService) ListTreatments(_ context.Context, req *connect.Request[treatmentv1.ListTreatmentsRequest]) (*connect.Response[treatmentv1.ListTreatmentsResponse], error) {
+	return record(f, req, &treatmentv1.ListTreatmentsResponse{})
+}
+
+func (f *fakeService) ListTreatmentsWithDetails(_ context.Context, req *connect.Request[treatmentv1alpha.ListTreatmentsWithDetailsRequest]) (*connect.Response[treatmentv1alpha.ListTreatmentsWithDetailsResponse], error) {
+	return record(f, req, &treatmentv1alpha.ListTreatmentsWithDetailsResponse{})
+}
+
+func (f *fakeService) CreateTreatment(_ context.Context, req *connect.Request[treatmentv1.Treatment]) (*connect.Response[treatmentv1.Treatment], error) {
+	return record(f, req, req.Msg)
+}
+
+func (f *fakeService) UpdateTreatment(_ context.Context, req *connect.Request[treatmentv1.UpdateTreatmentRequest]) (*connect.Response[treatmentv1.Treatment], error) {
+	return record(f, req, &treatmentv1.Treatment{Name: req.Msg.Name})
+}
+
+func (f *fakeService) DeleteTreatment(_ context.Context, req *connect.Request[treatmentv1.DeleteTreatmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return record(f, req, &emptypb.Empty{})
+}
+
+// newTestHandler returns the gateway handler calling fake through in-process
+// connect clients.
+func newTestHandler(fake *fakeService) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(treatmentv1connect.NewSpeciesServiceHandler(fake))
+	mux.Handle(treatmentv1connect.NewTreatmentServiceHandler(fake))
+	mux.Handle(treatmentv1alphaconnect.NewTreatmentDetailsServiceHandler(fake))
+
+	cli := NewInProcessClient(mux)
+
+	return New(
+		treatmentv1connect.NewSpeciesServiceClient(cli, InProcessBaseURL),
+		treatmentv1connect.NewTreatmentServiceClient(cli, InProcessBaseURL),
+		treatmentv1alphaconnect.NewTreatmentDetailsServiceClient(cli, InProcessBaseURL),
+	).Handler()
+}
+
+func serve(h http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func mask(paths ...string) *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func TestRoutes(t *testing.T) {
+	cases := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		status   int
+		expected proto.Message
+	}{
+		{"list species", http.MethodGet, "/v1/species?name=dog&name=cat", "", http.StatusOK,
+			&treatmentv1.ListSpeciesRequest{Names: []string{"dog", "cat"}}},
+		{"create species", http.MethodPost, "/v1/species", `{"name": "dog", "displayName": "Dog"}`, http.StatusCreated,
+			&treatmentv1.Species{Name: "dog", DisplayName: "Dog"}},
+		{"detect species", http.MethodGet, "/v1/species:detect?value=Hund", "", http.StatusOK,
+			&treatmentv1.DetectSpeciesRequest{Values: []string{"Hund"}}},
+		{"get species", http.MethodGet, "/v1/species/dog", "", http.StatusOK,
+			&treatmentv1.ListSpeciesRequest{Names: []string{"dog"}}},
+		{"update species", http.MethodPatch, "/v1/species/dog", `{"name": "ignored", "matchWords": ["Hund"], "displayName": "Dog"}`, http.StatusOK,
+			&treatmentv1.UpdateSpeciesRequest{
+				Name:       "dog",
+				Species:    &treatmentv1.Species{Name: "ignored", DisplayName: "Dog", MatchWords: []string{"Hund"}},
+				UpdateMask: mask("display_name", "match_words"),
+			}},
+		{"delete species", http.MethodDelete, "/v1/species/dog", "", http.StatusNoContent,
+			&treatmentv1.DeleteSpeciesRequest{Name: "dog"}},
+		{"list treatments", http.MethodGet, "/v1/treatments?species=dog&display_name=vacc", "", http.StatusOK,
+			&treatmentv1.ListTreatmentsRequest{Species: "dog", DisplayNameSearch: "vacc"}},
+		{"list treatments with details", http.MethodGet, "/v1/treatments:withDetails?species=dog&anesthesia=ANESTHESIA_FILTER_NOT_REQUIRED&start_time=09:30&patient.sex=SEX_MALE&patient.castration_status=CASTRATION_STATUS_INTACT&include_templates=true", "", http.StatusOK,
+			&treatmentv1alpha.ListTreatmentsWithDetailsRequest{
+				Species:          "dog",
+				Anesthesia:       treatmentv1alpha.AnesthesiaFilter_ANESTHESIA_FILTER_NOT_REQUIRED,
+				StartTime:        &commonv1.DayTime{Hour: 9, Minute: 30},
+				Patient:          &treatmentv1alpha.PatientAttributes{Sex: treatmentv1alpha.Sex_SEX_MALE, CastrationStatus: treatmentv1alpha.CastrationStatus_CASTRATION_STATUS_INTACT},
+				IncludeTemplates: true,
+			}},
+		{"list treatments with details without filters", http.MethodGet, "/v1/treatments:withDetails", "", http.StatusOK,
+			&treatmentv1alpha.ListTreatmentsWithDetailsRequest{}},
+		{"invalid anesthesia filter", http.MethodGet, "/v1/treatments:withDetails?anesthesia=yes", "", http.StatusBadRequest, nil},
+		{"invalid start time", http.MethodGet, "/v1/treatments:withDetails?start_time=9", "", http.StatusBadRequest, nil},
+		{"invalid patient sex", http.MethodGet, "/v1/treatments:withDetails?patient.sex=male", "", http.StatusBadRequest, nil},
+		{"create treatment", http.MethodPost, "/v1/treatments", `{"name": "vaccination", "species": ["dog"]}`, http.StatusCreated,
+			&treatmentv1.Treatment{Name: "vaccination", Species: []string{"dog"}}},
+		{"get treatment", http.MethodGet, "/v1/treatments/vaccination", "", http.StatusOK,
+			&treatmentv1.GetTreatmentRequest{Name: "vaccination"}},
+		{"update treatment", http.MethodPatch, "/v1/treatments/vaccination", `{"displayName": "Vaccination", "initialTimeRequirement": "900s"}`, http.StatusOK,
+			&treatmentv1.UpdateTreatmentRequest{
+				Name:                   "vaccination",
+				DisplayName:            "Vaccination",
+				InitialTimeRequirement: durationpb.New(15 * time.Minute),
+				UpdateMask:             mask("display_name", "initial_time_requirement"),
+			}},
+		{"update treatment with explicit mask", http.MethodPatch, "/v1/treatments/vaccination?update_mask=help_text", `{}`, http.StatusOK,
+			&treatmentv1.UpdateTreatmentRequest{
+				Name:       "vaccination",
+				UpdateMask: mask("help_text"),
+			}},
+		{"delete treatment", http.MethodDelete, "/v1/treatments/vaccination", "", http.StatusNoContent,
+			&treatmentv1.DeleteTreatmentRequest{Name: "vaccination"}},
+		{"unsupported method", http.MethodPut, "/v1/species/dog", `{}`, http.StatusMethodNotAllowed, nil},
+		{"unknown path", http.MethodGet, "/v1/unknown", "", http.StatusNotFound, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fake := new(fakeService)
+
+			rec := serve(newTestHandler(fake), c.method, c.target, c.body)
+			if rec.Code != c.status {
+				t.Fatalf("expected status %d but got %d: %s", c.status, rec.Code, rec.Body.String())
+			}
+
+			if c.expected == nil {
+				if len(fake.requests) > 0 {
+					t.Errorf("expected no call but got %v", fake.requests)
+				}
+
+				return
+			}
+
+			if len(fake.requests) != 1 {
+				t.Fatalf("expected exactly one call but got %d", len(fake.requests))
+			}
+
+			if !proto.Equal(fake.requests[0], c.expected) {
+				t.Errorf("expected request %v but got %v", c.expected, fake.requests[0])
+			}
+
+			if got := fake.header.Get("Authorization"); got != "Bearer token" {
+				t.Errorf("expected authorization header to be forwarded but got %q", got)
+			}
+		})
+	}
+}
+
+func TestEmptyPatch(t *testing.T) {
+	cases := []struct {
+		name   string
+		target string
+		body   string
+	}{
+		{"species without fields", "/v1/species/dog", `{}`},
+		{"species with name only", "/v1/species/dog", `{"name": "cat"}`},
+		{"treatment without fields", "/v1/treatments/vaccination", `{}`},
+		{"treatment with name only", "/v1/treatments/vaccination", `{"name": "castration"}`},
+		{"empty update_mask", "/v1/treatments/vaccination?update_mask=", `{}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fake := new(fakeService)
+
+			rec := serve(newTestHandler(fake), http.MethodPatch, c.target, c.body)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d but got %d: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+			}
+
+			if len(fake.requests) > 0 {
+				t.Errorf("expected the update to be rejected without calling the service but got %v", fake.requests)
+			}
+		})
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	for code, status := range httpStatus {
+		t.Run(code.String(), func(t *testing.T) {
+			fake := &fakeService{err: connect.NewError(code, errors.New("boom"))}
+
+			rec := serve(newTestHandler(fake), http.MethodGet, "/v1/treatments/vaccination", "")
+			if rec.Code != status {
+				t.Fatalf("expected status %d but got %d", status, rec.Code)
+			}
+
+			var body errorBody
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode error body: %s", err)
+			}
+
+			if body.Code != code.String() || body.Message != "boom" {
+				t.Errorf("expected error %s: boom but got %s: %s", code, body.Code, body.Message)
+			}
+		})
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	cerr := connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed"))
+
+	detail, err := connect.NewErrorDetail(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "name", Description: "must not be empty"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create error detail: %s", err)
+	}
+	cerr.AddDetail(detail)
+
+	rec := serve(newTestHandler(&fakeService{err: cerr}), http.MethodPost, "/v1/treatments", `{}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d but got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	var body struct {
+		Details []struct {
+			Type            string `json:"@type"`
+			FieldViolations []struct {
+				Field string `json:"field"`
+			} `json:"fieldViolations"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to decode error body: %s", err)
+	}
+
+	if len(body.Details) != 1 {
+		t.Fatalf("expected one error detail but got %d", len(body.Details))
+	}
+
+	if d := body.Details[0]; d.Type != "type.googleapis.com/google.rpc.BadRequest" || len(d.FieldViolations) != 1 || d.FieldViolations[0].Field != "name" {
+		t.Errorf("unexpected error detail %+v", d)
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	g := New(nil, nil, nil)
+
+	rec := serve(g.Handler(), http.MethodGet, OpenAPIPath, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d but got %d", http.StatusOK, rec.Code)
+	}
+
+	var doc struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			RequestBody struct {
+				Content map[string]struct {
+					Schema map[string]any `json:"schema"`
+				} `json:"content"`
+			} `json:"requestBody"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("failed to decode OpenAPI document: %s", err)
+	}
+
+	for _, rt := range g.routes() {
+		op, ok := doc.Paths[rt.Path][strings.ToLower(rt.Method)]
+		if !ok {
+			t.Errorf("%s %s is not documented", rt.Method, rt.Path)
+			continue
+		}
+
+		if op.OperationID != rt.OperationID {
+			t.Errorf("%s %s: expected operation %q but got %q", rt.Method, rt.Path, rt.OperationID, op.OperationID)
+		}
+	}
+
+	// the documented request body must match the decoded message
+	patch := doc.Paths["/v1/treatments/{name}"]["patch"].RequestBody.Content["application/json"].Schema
+	if ref := patch["$ref"]; ref != "#/components/schemas/tkd.treatment.v1.Treatment" {
+		t.Errorf("expected PATCH /v1/treatments/{name} to accept a Treatment but got %v", ref)
+	}
+
+	// all references must resolve
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok {
+				name := strings.TrimPrefix(ref, "#/components/schemas/")
+				if _, ok := doc.Components.Schemas[name]; !ok {
+					t.Errorf("unresolved schema reference %q", ref)
+				}
+			}
+
+			for _, child := range v {
+				walk(child)
+			}
+
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+
+	var raw any
+	if err := json.Unmarshal(rec.Body.Bytes(), &raw); err != nil {
+		t.Fatalf("failed to decode OpenAPI document: %s", err)
+	}
+	walk(raw)
+}
@@ -0,0 +1,245 @@
+package gateway
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// errorSchema is the name of the error schema in the OpenAPI document.
+const errorSchema = "Error"
+
+// openAPI returns the OpenAPI 3 document describing routes. Schemas are
+// generated from the protobuf descriptors following the protojson mapping.
+func openAPI(routes []route) map[string]any {
+	schemas := map[string]any{
+		errorSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code":    map[string]any{"type": "string", "description": "The connect error code, e.g. invalid_argument."},
+				"message": map[string]any{"type": "string"},
+				"details": map[string]any{
+					"type":        "array",
+					"description": "Error details encoded as google.protobuf.Any, e.g. google.rpc.BadRequest.",
+					"items":       map[string]any{"type": "object"},
+				},
+			},
+		},
+	}
+
+	paths := make(map[string]any)
+
+	for _, rt := range routes {
+		op := map[string]any{
+			"operationId": rt.OperationID,
+			"summary":     rt.Summary,
+		}
+
+		if len(rt.Params) > 0 {
+			params := make([]any, len(rt.Params))
+			for idx, p := range rt.Params {
+				schema := map[string]any{"type": "string"}
+				if p.Type != "" {
+					schema["type"] = p.Type
+				}
+
+				if p.Enum != nil {
+					schema["enum"] = enumNames(p.Enum)
+				}
+
+				if p.Repeated {
+					schema = map[string]any{"type": "array", "items": schema}
+				}
+
+				params[idx] = map[string]any{
+					"name":        p.Name,
+					"in":          p.In,
+					"description": p.Description,
+					"required":    p.In == "path",
+					"schema":      schema,
+				}
+			}
+
+			op["parameters"] = params
+		}
+
+		if rt.Body != nil {
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content": map[string]any{
+					"application/json": map[string]any{
+						"schema": messageRef(rt.Body, schemas),
+					},
+				},
+			}
+		}
+
+		success := map[string]any{
+			"description": http.StatusText(rt.Status),
+		}
+		if rt.Response != nil {
+			success["content"] = map[string]any{
+				"application/json": map[string]any{
+					"schema": messageRef(rt.Response, schemas),
+				},
+			}
+		}
+
+		op["responses"] = map[string]any{
+			strconv.Itoa(rt.Status): success,
+			"default": map[string]any{
+				"description": "Error",
+				"content": map[string]any{
+					"application/json": map[string]any{
+						"schema": map[string]any{"$ref": "#/components/schemas/" + errorSchema},
+					},
+				},
+			},
+		}
+
+		item, ok := paths[rt.Path].(map[string]any)
+		if !ok {
+			item = make(map[string]any)
+			paths[rt.Path] = item
+		}
+
+		item[strings.ToLower(rt.Method)] = op
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Treatment Service",
+			"version": "v1",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+		},
+	}
+}
+
+// messageRef returns the schema for md, adding the schemas of md and all
+// referenced messages to schemas.
+func messageRef(md protoreflect.MessageDescriptor, schemas map[string]any) map[string]any {
+	if s, ok := wellKnownSchema(md); ok {
+		return s
+	}
+
+	name := string(md.FullName())
+
+	if _, ok := schemas[name]; !ok {
+		properties := make(map[string]any)
+
+		// register the schema before resolving the fields to support
+		// recursive messages.
+		schemas[name] = map[string]any{
+			"type":       "object",
+			"properties": properties,
+		}
+
+		fields := md.Fields()
+		for i := range fields.Len() {
+			fd := fields.Get(i)
+			properties[fd.JSONName()] = fieldSchema(fd, schemas)
+		}
+	}
+
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	switch {
+	case fd.IsMap():
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": singularSchema(fd.MapValue(), schemas),
+		}
+
+	case fd.IsList():
+		return map[string]any{
+			"type":  "array",
+			"items": singularSchema(fd, schemas),
+		}
+	}
+
+	return singularSchema(fd, schemas)
+}
+
+func singularSchema(fd protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson encodes 64-bit integers as strings
+		return map[string]any{"type": "string", "format": "int64"}
+
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+
+	case protoreflect.EnumKind:
+		return map[string]any{"type": "string", "enum": enumNames(fd.Enum())}
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(fd.Message(), schemas)
+	}
+
+	return map[string]any{}
+}
+
+// wellKnownSchema returns the schema of well-known types that have a special
+// JSON representation.
+func wellKnownSchema(md protoreflect.MessageDescriptor) (map[string]any, bool) {
+	switch md.FullName() {
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "description": "Duration in seconds with up to nine fractional digits, suffixed with \"s\", e.g. \"900s\"."}, true
+
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}, true
+
+	case "google.protobuf.FieldMask":
+		return map[string]any{"type": "string", "description": "Comma separated list of field paths."}, true
+
+	case "google.protobuf.Empty":
+		return map[string]any{"type": "object"}, true
+
+	case "google.protobuf.Struct":
+		return map[string]any{"type": "object"}, true
+
+	case "google.protobuf.Value":
+		return map[string]any{}, true
+	}
+
+	return nil, false
+}
+
+// enumNames returns the names of all values of ed.
+func enumNames(ed protoreflect.EnumDescriptor) []string {
+	values := ed.Values()
+
+	names := make([]string, values.Len())
+	for i := range values.Len() {
+		names[i] = string(values.Get(i).Name())
+	}
+
+	return names
+}
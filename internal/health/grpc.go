@@ -0,0 +1,86 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// watchInterval is the interval at which the readiness is re-evaluated for
+// Watch calls.
+const watchInterval = 5 * time.Second
+
+const (
+	checkProcedure = "/grpc.health.v1.Health/Check"
+	watchProcedure = "/grpc.health.v1.Health/Watch"
+)
+
+// NewGRPCHandler returns a handler implementing the gRPC health service
+// (grpc.health.v1.Health). The empty service name reports the overall
+// status, all other services must be listed in services.
+func NewGRPCHandler(c *Checker, services []string, opts ...connect.HandlerOption) (string, http.Handler) {
+	h := &grpcHandler{checker: c, services: services}
+
+	mux := http.NewServeMux()
+	mux.Handle(checkProcedure, connect.NewUnaryHandler(checkProcedure, h.Check, opts...))
+	mux.Handle(watchProcedure, connect.NewServerStreamHandler(watchProcedure, h.Watch, opts...))
+
+	return "/grpc.health.v1.Health/", mux
+}
+
+type grpcHandler struct {
+	checker  *Checker
+	services []string
+}
+
+func (h *grpcHandler) status(ctx context.Context, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if service != "" && !slices.Contains(h.services, service) {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+
+	if ready, _ := h.checker.Ready(ctx); !ready {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+func (h *grpcHandler) Check(ctx context.Context, req *connect.Request[grpc_health_v1.HealthCheckRequest]) (*connect.Response[grpc_health_v1.HealthCheckResponse], error) {
+	status := h.status(ctx, req.Msg.Service)
+	if status == grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("unknown service"))
+	}
+
+	return connect.NewResponse(&grpc_health_v1.HealthCheckResponse{
+		Status: status,
+	}), nil
+}
+
+func (h *grpcHandler) Watch(ctx context.Context, req *connect.Request[grpc_health_v1.HealthCheckRequest], stream *connect.ServerStream[grpc_health_v1.HealthCheckResponse]) error {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+
+	for {
+		// only send a message if the status changed
+		if status := h.status(ctx, req.Msg.Service); status != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: status}); err != nil {
+				return err
+			}
+
+			last = status
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Package health implements liveness and readiness checks exposed as HTTP
+// endpoints and using the standard gRPC health service.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// checkTimeout is the maximum time spent on all readiness checks.
+const checkTimeout = 5 * time.Second
+
+// Check returns an error if the checked component is not ready.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs readiness checks.
+type Checker struct {
+	checks []namedCheck
+}
+
+// New returns a new checker without any checks.
+func New() *Checker {
+	return &Checker{}
+}
+
+// Add adds a readiness check. Checks are run in the order they are added.
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Result holds the outcome of a single readiness check.
+type Result struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// Ready runs all readiness checks and reports whether all of them passed.
+func (c *Checker) Ready(ctx context.Context) (bool, []Result) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	ready := true
+	results := make([]Result, len(c.checks))
+
+	for idx, nc := range c.checks {
+		results[idx].Name = nc.name
+
+		if err := nc.check(ctx); err != nil {
+			ready = false
+			results[idx].Error = err.Error()
+		}
+	}
+
+	return ready, results
+}
+
+// LivenessHandler returns a handler that reports whether the process is able
+// to serve requests. It does not run any readiness checks so an unavailable
+// database does not cause the service to be restarted.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"status": "ok",
+		})
+	})
+}
+
+// ReadinessHandler returns a handler that runs all readiness checks and
+// responds with 503 Service Unavailable if any of them fails. The handler is
+// reachable without authentication so only the names of failed checks are
+// reported, errors are logged instead.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ready, results := c.Ready(r.Context())
+
+		status, code := "ok", http.StatusOK
+		if !ready {
+			status, code = "unavailable", http.StatusServiceUnavailable
+		}
+
+		checks := make(map[string]string, len(results))
+		for _, res := range results {
+			if res.Error == "" {
+				checks[res.Name] = "ok"
+				continue
+			}
+
+			checks[res.Name] = "failed"
+			slog.WarnContext(r.Context(), "readiness check failed", "check", res.Name, "error", res.Error)
+		}
+
+		writeJSON(w, code, map[string]any{
+			"status": status,
+			"checks": checks,
+		})
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,81 @@
+package instructions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Supported placeholders. Placeholders are written as {{name}} and may
+// contain surrounding whitespace, e.g. {{ pet_name }}.
+const (
+	PetName         = "pet_name"
+	AppointmentDate = "appointment_date"
+	AppointmentTime = "appointment_time"
+)
+
+const (
+	dateFormat = "02.01.2006"
+	timeFormat = "15:04"
+)
+
+// placeholderRe matches everything enclosed in double braces so misspelled
+// placeholders like {{pet-name}} are reported by Validate.
+var placeholderRe = regexp.MustCompile(`{{\s*([^{}]*?)\s*}}`)
+
+// Context holds the values used to fill in placeholders.
+type Context struct {
+	PetName         string
+	AppointmentTime time.Time
+}
+
+// Validate ensures tmpl only uses supported placeholders.
+func Validate(tmpl string) error {
+	for _, m := range placeholderRe.FindAllStringSubmatch(tmpl, -1) {
+		switch m[1] {
+		case PetName, AppointmentDate, AppointmentTime:
+		default:
+			return fmt.Errorf("unsupported placeholder %q", m[0])
+		}
+	}
+
+	return nil
+}
+
+// Render fills in all placeholders of tmpl. Placeholders without a value in
+// c are rendered as an empty string and returned as missing, each only once.
+// Unsupported placeholders are kept as they are.
+func Render(tmpl string, c Context) (string, []string) {
+	var missing []string
+
+	result := placeholderRe.ReplaceAllStringFunc(tmpl, func(s string) string {
+		var value string
+
+		name := placeholderRe.FindStringSubmatch(s)[1]
+
+		switch name {
+		case PetName:
+			value = c.PetName
+		case AppointmentDate:
+			if !c.AppointmentTime.IsZero() {
+				value = c.AppointmentTime.Format(dateFormat)
+			}
+		case AppointmentTime:
+			if !c.AppointmentTime.IsZero() {
+				value = c.AppointmentTime.Format(timeFormat)
+			}
+		default:
+			return s
+		}
+
+		if value == "" && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+
+		return value
+	})
+
+	return strings.TrimSpace(result), missing
+}
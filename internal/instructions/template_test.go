@@ -0,0 +1,92 @@
+package instructions
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		tmpl  string
+		valid bool
+	}{
+		{"Bitte nüchtern erscheinen.", true},
+		{"{{pet_name}} muss nüchtern sein.", true},
+		{"Termin am {{ appointment_date }} um {{appointment_time}}", true},
+		{"{{owner_name}}", false},
+		{"{{pet-name}}", false},
+		{"{{}}", false},
+		{"{pet_name}", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.tmpl, func(t *testing.T) {
+			if err := Validate(c.tmpl); (err == nil) != c.valid {
+				t.Errorf("expected valid=%t but got error %v", c.valid, err)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	vienna, err := time.LoadLocation("Europe/Vienna")
+	if err != nil {
+		t.Skipf("time zone database not available: %s", err)
+	}
+
+	// 07:30 UTC is 09:30 in Vienna (CEST)
+	appointment := time.Date(2024, 6, 3, 7, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		tmpl     string
+		ctx      Context
+		expected string
+		missing  []string
+	}{
+		{
+			name:     "all placeholders",
+			tmpl:     "{{pet_name}} am {{ appointment_date }} um {{appointment_time}}",
+			ctx:      Context{PetName: "Minka", AppointmentTime: appointment.In(vienna)},
+			expected: "Minka am 03.06.2024 um 09:30",
+		},
+		{
+			name:     "time zone",
+			tmpl:     "{{appointment_date}} {{appointment_time}}",
+			ctx:      Context{AppointmentTime: time.Date(2024, 6, 3, 23, 30, 0, 0, time.UTC).In(vienna)},
+			expected: "04.06.2024 01:30",
+		},
+		{
+			name:     "missing values",
+			tmpl:     "{{pet_name}} um {{appointment_time}}, {{pet_name}}",
+			expected: "um ,",
+			missing:  []string{PetName, AppointmentTime},
+		},
+		{
+			name:     "unknown placeholder",
+			tmpl:     "Hallo {{owner_name}}",
+			ctx:      Context{PetName: "Minka"},
+			expected: "Hallo {{owner_name}}",
+		},
+		{
+			name:     "no placeholders",
+			tmpl:     "  Bitte nüchtern erscheinen.  ",
+			expected: "Bitte nüchtern erscheinen.",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, missing := Render(c.tmpl, c.ctx)
+
+			if got != c.expected {
+				t.Errorf("expected %q but got %q", c.expected, got)
+			}
+
+			if !slices.Equal(missing, c.missing) {
+				t.Errorf("expected missing placeholders %v but got %v", c.missing, missing)
+			}
+		})
+	}
+}
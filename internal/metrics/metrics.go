@@ -0,0 +1,159 @@
+// Package metrics exposes Prometheus metrics for RPCs, repository
+// operations, the catalog size and detection outcomes.
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "treatment_service"
+
+// Detection outcomes.
+const (
+	OutcomeNoMatch   = "no_match"
+	OutcomeSingle    = "single"
+	OutcomeAmbiguous = "ambiguous"
+)
+
+// catalogTimeout is the maximum time spent counting catalog items during a
+// scrape.
+const catalogTimeout = 5 * time.Second
+
+var (
+	// Registry holds all metrics of the service.
+	Registry = prometheus.NewRegistry()
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "Duration of RPCs by procedure.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"procedure"})
+
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_requests_total",
+		Help:      "Number of handled RPCs by procedure and status code.",
+	}, []string{"procedure", "code"})
+
+	repositoryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "repository_duration_seconds",
+		Help:      "Duration of repository operations including all database round-trips by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	detectionOutcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "detection_outcomes_total",
+		Help:      "Number of detection requests by kind and outcome (no_match, single, ambiguous).",
+	}, []string{"kind", "outcome"})
+
+	catalogItemsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "catalog", "items"),
+		"Number of catalog items by kind.",
+		[]string{"kind"},
+		nil,
+	)
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcDuration,
+		rpcRequests,
+		repositoryDuration,
+		detectionOutcomes,
+	)
+}
+
+// Handler returns the HTTP handler serving all metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// NewInterceptor returns a connect interceptor that records the duration
+// and status code of each RPC.
+func NewInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+
+			res, err := next(ctx, ar)
+
+			procedure := ar.Spec().Procedure
+
+			code := "ok"
+			if err != nil {
+				code = connect.CodeOf(err).String()
+			}
+
+			rpcDuration.WithLabelValues(procedure).Observe(time.Since(start).Seconds())
+			rpcRequests.WithLabelValues(procedure, code).Inc()
+
+			return res, err
+		}
+	}
+}
+
+// ObserveRepository records the duration of the repository method that
+// started at start. It is meant to be deferred.
+func ObserveRepository(method string, start time.Time) {
+	repositoryDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// ObserveDetection records the outcome of a detection request of the given
+// kind based on the number of matches.
+func ObserveDetection(kind string, matches int) {
+	outcome := OutcomeAmbiguous
+
+	switch matches {
+	case 0:
+		outcome = OutcomeNoMatch
+	case 1:
+		outcome = OutcomeSingle
+	}
+
+	detectionOutcomes.WithLabelValues(kind, outcome).Inc()
+}
+
+// CatalogCounter returns the number of catalog items by kind.
+type CatalogCounter func(ctx context.Context) (map[string]int64, error)
+
+type catalogCollector struct {
+	count CatalogCounter
+}
+
+func (c *catalogCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- catalogItemsDesc
+}
+
+func (c *catalogCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), catalogTimeout)
+	defer cancel()
+
+	counts, err := c.count(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count catalog items", "error", err)
+		return
+	}
+
+	for kind, n := range counts {
+		ch <- prometheus.MustNewConstMetric(catalogItemsDesc, prometheus.GaugeValue, float64(n), kind)
+	}
+}
+
+// RegisterCatalog registers gauges for the catalog size. The catalog items
+// are counted during each scrape.
+func RegisterCatalog(count CatalogCounter) error {
+	return Registry.Register(&catalogCollector{count: count})
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/tierklinik-dobersberg/apis/pkg/server"
+	"golang.org/x/time/rate"
+)
+
+// idleTimeout defines after which time a client limiter is removed if the
+// client did not send any requests.
+const idleTimeout = 10 * time.Minute
+
+type client struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter is a per-client rate limiter. Clients are identified by their
+// (real) IP address.
+type Limiter struct {
+	limit rate.Limit
+	burst int
+
+	l           sync.Mutex
+	clients     map[string]*client
+	lastCleanup time.Time
+}
+
+// New returns a new per-client limiter that allows up to requestsPerMinute
+// requests with the given burst size.
+func New(requestsPerMinute float64, burst int) *Limiter {
+	return &Limiter{
+		limit:   rate.Limit(requestsPerMinute / 60),
+		burst:   burst,
+		clients: make(map[string]*client),
+	}
+}
+
+// Allow reports whether the client identified by key may perform
+// another request.
+func (l *Limiter) Allow(key string) bool {
+	l.l.Lock()
+	defer l.l.Unlock()
+
+	now := time.Now()
+
+	// remove idle clients so the map does not grow unbounded
+	if now.Sub(l.lastCleanup) > time.Minute {
+		for k, c := range l.clients {
+			if now.Sub(c.lastSeen) > idleTimeout {
+				delete(l.clients, k)
+			}
+		}
+
+		l.lastCleanup = now
+	}
+
+	c, ok := l.clients[key]
+	if !ok {
+		c = &client{
+			limiter: rate.NewLimiter(l.limit, l.burst),
+		}
+		l.clients[key] = c
+	}
+
+	c.lastSeen = now
+
+	return c.limiter.AllowN(now, 1)
+}
+
+// NewInterceptor returns a connect interceptor that rejects requests with
+// CodeResourceExhausted if the client exceeded the rate limit.
+func NewInterceptor(l *Limiter) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+			if !l.Allow(clientKey(ctx, ar)) {
+				return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many requests"))
+			}
+
+			return next(ctx, ar)
+		}
+	}
+}
+
+func clientKey(ctx context.Context, ar connect.AnyRequest) string {
+	if ip := server.RealIPFromContext(ctx); ip != nil {
+		return ip.String()
+	}
+
+	addr := ar.Peer().Addr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
+}
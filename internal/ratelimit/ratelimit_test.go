@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestAllow(t *testing.T) {
+	// one request per hour, i.e. no tokens are refilled during the test
+	l := New(1.0/60, 2)
+
+	for i := 0; i < 2; i++ {
+		if !l.Allow("a") {
+			t.Fatalf("request %d should be allowed within the burst", i)
+		}
+	}
+
+	if l.Allow("a") {
+		t.Errorf("request exceeding the burst should be rejected")
+	}
+
+	if !l.Allow("b") {
+		t.Errorf("clients should be limited independently")
+	}
+}
+
+func TestAllowRemovesIdleClients(t *testing.T) {
+	l := New(1.0/60, 1)
+
+	if !l.Allow("a") {
+		t.Fatalf("first request should be allowed")
+	}
+
+	// pretend the client has been idle and the last cleanup is due
+	l.clients["a"].lastSeen = time.Now().Add(-2 * idleTimeout)
+	l.lastCleanup = time.Now().Add(-2 * time.Minute)
+
+	if !l.Allow("b") {
+		t.Fatalf("first request should be allowed")
+	}
+
+	if _, ok := l.clients["a"]; ok {
+		t.Errorf("idle client should have been removed")
+	}
+
+	if !l.Allow("a") {
+		t.Errorf("removed client should start with a full burst")
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	calls := 0
+	next := connect.UnaryFunc(func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+		calls++
+
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	})
+
+	handler := NewInterceptor(New(1.0/60, 1))(next)
+
+	if _, err := handler(context.Background(), connect.NewRequest(&emptypb.Empty{})); err != nil {
+		t.Fatalf("first request should be allowed, got %s", err)
+	}
+
+	_, err := handler(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Errorf("expected %s but got %v", connect.CodeResourceExhausted, err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected the rejected request to not be handled, got %d calls", calls)
+	}
+}
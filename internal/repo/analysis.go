@@ -0,0 +1,253 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r *Repository) AnalyzeMatchRules(ctx context.Context) ([]*treatmentv1alpha.MatchRuleIssue, error) {
+	ctx, end := observe(ctx, "AnalyzeMatchRules")
+	defer end()
+
+	species, err := r.ListSpecies(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	treatments, err := r.findTreatments(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	breeds, err := r.ListBreeds(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := r.loadSpeciesTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return analyzeMatchRules(tree, species, treatments, breeds), nil
+}
+
+// analyzeMatchRules reports overlapping, subsumed and shadowed match words
+// of species, treatments and breeds. Treatments are only compared with each
+// other if they are applicable to at least one common species, including
+// species inherited from species groups. Breeds are only compared if they
+// belong to the same species.
+func analyzeMatchRules(tree *speciesTree, species []*treatmentv1.Species, treatments []*treatmentv1.Treatment, breeds []*treatmentv1alpha.Breed) []*treatmentv1alpha.MatchRuleIssue {
+	var issues []*treatmentv1alpha.MatchRuleIssue
+
+	speciesRules := make([]matchRules, len(species))
+	for idx, s := range species {
+		speciesRules[idx] = matchRules{
+			kind:  treatmentv1alpha.EntityKind_ENTITY_KIND_SPECIES,
+			name:  s.Name,
+			words: s.MatchWords,
+		}
+	}
+
+	treatmentRules := make([]matchRules, len(treatments))
+	for idx, t := range treatments {
+		treatmentRules[idx] = matchRules{
+			kind:  treatmentv1alpha.EntityKind_ENTITY_KIND_TREATMENT,
+			name:  t.Name,
+			words: t.MatchEventText,
+		}
+	}
+
+	breedRules := make([]matchRules, len(breeds))
+	for idx, b := range breeds {
+		breedRules[idx] = matchRules{
+			kind:  treatmentv1alpha.EntityKind_ENTITY_KIND_BREED,
+			name:  b.Name,
+			words: b.MatchWords,
+		}
+	}
+
+	for _, rules := range slices.Concat(speciesRules, treatmentRules, breedRules) {
+		issues = append(issues, rules.selfIssues()...)
+	}
+
+	for i := range speciesRules {
+		for j := i + 1; j < len(speciesRules); j++ {
+			issues = append(issues, compareRules(speciesRules[i], speciesRules[j], "")...)
+		}
+	}
+
+	for i := range treatments {
+		for j := i + 1; j < len(treatments); j++ {
+			for _, s := range commonSpecies(tree, treatments[i].Species, treatments[j].Species) {
+				issues = append(issues, compareRules(treatmentRules[i], treatmentRules[j], s)...)
+			}
+		}
+	}
+
+	for i := range breeds {
+		for j := i + 1; j < len(breeds); j++ {
+			if breeds[i].Species == breeds[j].Species {
+				issues = append(issues, compareRules(breedRules[i], breedRules[j], breeds[i].Species)...)
+			}
+		}
+	}
+
+	return issues
+}
+
+// IssueKey returns a key that uniquely identifies the issue.
+func IssueKey(i *treatmentv1alpha.MatchRuleIssue) string {
+	return strings.Join([]string{
+		i.Kind.String(),
+		i.EntityKind.String(),
+		i.Entity,
+		i.MatchWord,
+		i.ConflictingEntity,
+		i.ConflictingMatchWord,
+		i.Species,
+	}, "\x00")
+}
+
+type matchRules struct {
+	kind  treatmentv1alpha.EntityKind
+	name  string
+	words []string
+}
+
+func (m matchRules) issue(kind treatmentv1alpha.MatchRuleIssueKind, word string, description string, args ...any) *treatmentv1alpha.MatchRuleIssue {
+	return &treatmentv1alpha.MatchRuleIssue{
+		Kind:        kind,
+		EntityKind:  m.kind,
+		Entity:      m.name,
+		MatchWord:   word,
+		Description: fmt.Sprintf(description, args...),
+	}
+}
+
+func (m matchRules) selfIssues() []*treatmentv1alpha.MatchRuleIssue {
+	var issues []*treatmentv1alpha.MatchRuleIssue
+
+	if len(m.words) == 0 {
+		issues = append(issues, m.issue(treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_NO_MATCH_WORDS, "", "%s does not have any match words", m.name))
+	}
+
+	for i, wi := range m.words {
+		ni := strings.TrimSpace(textmatch.Normalize(wi))
+
+		if ni == "" {
+			issues = append(issues, m.issue(treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_BLANK_MATCH_WORD, wi, "%s has a blank match word that matches any value", m.name))
+			continue
+		}
+
+		for j, wj := range m.words {
+			if i == j {
+				continue
+			}
+
+			nj := strings.TrimSpace(textmatch.Normalize(wj))
+			if nj == "" || !strings.Contains(ni, nj) {
+				continue
+			}
+
+			// for duplicates within the same entity only report the
+			// second occurrence.
+			if ni == nj && j > i {
+				continue
+			}
+
+			issue := m.issue(treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SHADOWED, wi, "match word %q of %s is shadowed by %q", wi, m.name, wj)
+			issue.ConflictingEntity = m.name
+			issue.ConflictingMatchWord = wj
+
+			issues = append(issues, issue)
+
+			break
+		}
+	}
+
+	return issues
+}
+
+func compareRules(a, b matchRules, species string) []*treatmentv1alpha.MatchRuleIssue {
+	var issues []*treatmentv1alpha.MatchRuleIssue
+
+	scope := ""
+	if species != "" {
+		scope = fmt.Sprintf(" for species %s", species)
+	}
+
+	for _, wa := range a.words {
+		na := strings.TrimSpace(textmatch.Normalize(wa))
+		if na == "" {
+			continue
+		}
+
+		for _, wb := range b.words {
+			nb := strings.TrimSpace(textmatch.Normalize(wb))
+			if nb == "" {
+				continue
+			}
+
+			var issue *treatmentv1alpha.MatchRuleIssue
+			switch {
+			case na == nb:
+				issue = a.issue(treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_DUPLICATE, wa, "%s and %s both use the match word %q%s", a.name, b.name, wa, scope)
+				issue.ConflictingEntity = b.name
+				issue.ConflictingMatchWord = wb
+
+			case strings.Contains(nb, na):
+				issue = b.issue(treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SUBSUMED, wb, "every value matching %q of %s also matches %q of %s%s", wb, b.name, wa, a.name, scope)
+				issue.ConflictingEntity = a.name
+				issue.ConflictingMatchWord = wa
+
+			case strings.Contains(na, nb):
+				issue = a.issue(treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SUBSUMED, wa, "every value matching %q of %s also matches %q of %s%s", wa, a.name, wb, b.name, scope)
+				issue.ConflictingEntity = b.name
+				issue.ConflictingMatchWord = wb
+
+			default:
+				continue
+			}
+
+			issue.Species = species
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// commonSpecies returns all species two treatments are applicable to.
+// A treatment without species applies to all species and an empty species
+// name is returned if both treatments apply to all species. Treatments
+// assigned to a species group apply to all species of the group. Species
+// are omitted if one of their ancestors is returned already.
+func commonSpecies(tree *speciesTree, a, b []string) []string {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return []string{""}
+	case len(a) == 0:
+		return tree.topmost(tree.Expand(b))
+	case len(b) == 0:
+		return tree.topmost(tree.Expand(a))
+	}
+
+	expanded := tree.Expand(b)
+
+	var result []string
+	for _, s := range tree.Expand(a) {
+		if slices.Contains(expanded, s) && !slices.Contains(result, s) {
+			result = append(result, s)
+		}
+	}
+
+	return tree.topmost(result)
+}
@@ -0,0 +1,103 @@
+package repo
+
+import (
+	"slices"
+	"testing"
+
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+)
+
+// issueSummary is a condensed representation of a match rule issue used to
+// compare analysis results.
+type issueSummary struct {
+	kind     treatmentv1alpha.MatchRuleIssueKind
+	entity   string
+	word     string
+	conflict string
+	species  string
+}
+
+func summarize(issues []*treatmentv1alpha.MatchRuleIssue) []issueSummary {
+	result := make([]issueSummary, len(issues))
+	for idx, i := range issues {
+		result[idx] = issueSummary{i.Kind, i.Entity, i.MatchWord, i.ConflictingEntity, i.Species}
+	}
+
+	return result
+}
+
+func TestAnalyzeMatchRules(t *testing.T) {
+	species := []*treatmentv1.Species{
+		{Name: "cat", MatchWords: []string{"Katze", "Kater"}},
+		{Name: "tomcat", MatchWords: []string{"Kater"}},
+		{Name: "dog", MatchWords: []string{"Hund", "Hundewelpe"}},
+		{Name: "rabbit"},
+		{Name: "small-mammals", MatchWords: []string{"Kleinsäuger"}},
+	}
+
+	tree := &speciesTree{
+		names:   []string{"cat", "tomcat", "dog", "rabbit", "small-mammals"},
+		parents: map[string]string{"rabbit": "small-mammals"},
+	}
+
+	treatments := []*treatmentv1.Treatment{
+		{Name: "vaccination", Species: []string{"cat"}, MatchEventText: []string{"Impfung"}},
+		{Name: "rabies", Species: []string{"cat", "dog"}, MatchEventText: []string{"Tollwut Impfung"}},
+		{Name: "castration", Species: []string{"dog"}, MatchEventText: []string{"Kastration", " "}},
+		{Name: "checkup", Species: []string{"small-mammals"}, MatchEventText: []string{"Kontrolle"}},
+		{Name: "dental", Species: []string{"rabbit"}, MatchEventText: []string{"Zahn Kontrolle"}},
+	}
+
+	breeds := []*treatmentv1alpha.Breed{
+		{Name: "persian", Species: "cat", MatchWords: []string{"Perser"}},
+		{Name: "persian-longhair", Species: "cat", MatchWords: []string{"Perser Langhaar"}},
+		{Name: "persian-dog", Species: "dog", MatchWords: []string{"Perser"}},
+	}
+
+	expected := []issueSummary{
+		{treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SHADOWED, "dog", "Hundewelpe", "dog", ""},
+		{treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_NO_MATCH_WORDS, "rabbit", "", "", ""},
+		{treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_BLANK_MATCH_WORD, "castration", " ", "", ""},
+		{treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_DUPLICATE, "cat", "Kater", "tomcat", ""},
+		{treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SUBSUMED, "rabies", "Tollwut Impfung", "vaccination", "cat"},
+		{treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SUBSUMED, "dental", "Zahn Kontrolle", "checkup", "rabbit"},
+		{treatmentv1alpha.MatchRuleIssueKind_MATCH_RULE_ISSUE_KIND_SUBSUMED, "persian-longhair", "Perser Langhaar", "persian", "cat"},
+	}
+
+	if got := summarize(analyzeMatchRules(tree, species, treatments, breeds)); !slices.Equal(got, expected) {
+		t.Errorf("expected issues\n%v\nbut got\n%v", expected, got)
+	}
+}
+
+func TestCommonSpecies(t *testing.T) {
+	tree := &speciesTree{
+		names: []string{"cat", "dog", "small-mammals", "rabbit", "hamster"},
+		parents: map[string]string{
+			"rabbit":  "small-mammals",
+			"hamster": "small-mammals",
+		},
+	}
+
+	cases := []struct {
+		name     string
+		a, b     []string
+		expected []string
+	}{
+		{"all species", nil, nil, []string{""}},
+		{"one applies to all species", nil, []string{"cat"}, []string{"cat"}},
+		{"overlap", []string{"cat", "dog"}, []string{"dog", "rabbit"}, []string{"dog"}},
+		{"disjoint", []string{"cat"}, []string{"dog"}, nil},
+		{"group and member", []string{"small-mammals"}, []string{"rabbit", "cat"}, []string{"rabbit"}},
+		{"same group", []string{"small-mammals"}, []string{"small-mammals", "hamster"}, []string{"small-mammals"}},
+		{"group and all species", []string{"small-mammals", "rabbit"}, nil, []string{"small-mammals"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := commonSpecies(tree, c.a, c.b); !slices.Equal(got, c.expected) {
+				t.Errorf("expected %v but got %v", c.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/validation"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BatchMutateTreatments validates and applies all mutations in a single
+// transaction. If any mutation fails, the transaction is aborted and the
+// returned error carries the per-mutation results as an error detail.
+func (r *Repository) BatchMutateTreatments(ctx context.Context, mutations []*treatmentv1alpha.TreatmentMutation) (*treatmentv1alpha.BatchMutateTreatmentsResponse, error) {
+	ctx, end := observe(ctx, "BatchMutateTreatments")
+	defer end()
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		response := &treatmentv1alpha.BatchMutateTreatmentsResponse{
+			Results: make([]*treatmentv1alpha.TreatmentMutationResult, len(mutations)),
+		}
+
+		for idx := range mutations {
+			response.Results[idx] = &treatmentv1alpha.TreatmentMutationResult{
+				Index: int32(idx),
+			}
+		}
+
+		// validate all mutations against the state they would produce before
+		// changing anything.
+		if err := r.validateMutations(ctx, mutations, response); err != nil {
+			return nil, err
+		}
+
+		for idx, m := range mutations {
+			var (
+				t   *treatmentv1.Treatment
+				err error
+			)
+
+			switch kind := m.Kind.(type) {
+			case *treatmentv1alpha.TreatmentMutation_Create:
+				t, err = r.CreateTreatment(ctx, kind.Create)
+
+			case *treatmentv1alpha.TreatmentMutation_Update:
+				t, err = r.UpdateTreatment(ctx, kind.Update)
+
+			case *treatmentv1alpha.TreatmentMutation_Delete:
+				err = r.DeleteTreatment(ctx, kind.Delete)
+			}
+
+			if err != nil {
+				response.Results[idx].Error = err.Error()
+				return nil, newBatchMutationError(err, response)
+			}
+
+			response.Results[idx].Treatment = t
+		}
+
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.BatchMutateTreatmentsResponse), nil
+}
+
+func (r *Repository) validateMutations(ctx context.Context, mutations []*treatmentv1alpha.TreatmentMutation, response *treatmentv1alpha.BatchMutateTreatmentsResponse) error {
+	tree, err := r.loadSpeciesTree(ctx)
+	if err != nil {
+		return err
+	}
+
+	// state holds the treatments as they would look after applying the
+	// previous mutations. A nil value means the treatment does not exist.
+	state := make(map[string]*Treatment)
+
+	get := func(name string) (*Treatment, error) {
+		if t, ok := state[name]; ok {
+			return t, nil
+		}
+
+		t, err := r.findTreatment(ctx, name)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				state[name] = nil
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		state[name] = &t
+
+		return &t, nil
+	}
+
+	validate := func(t Treatment, v *validation.Violations) error {
+		for idx, s := range t.Species {
+			if _, ok := tree.parents[s]; !ok {
+				v.Add(fmt.Sprintf("species[%d]", idx), "species %q not found", s)
+			}
+		}
+
+		return v.Err()
+	}
+
+	var first error
+
+	for idx, m := range mutations {
+		var err error
+
+		switch kind := m.Kind.(type) {
+		case *treatmentv1alpha.TreatmentMutation_Create:
+			var cur *Treatment
+			cur, err = get(kind.Create.Name)
+			if err != nil {
+				return err
+			}
+
+			if cur != nil {
+				err = connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("treatment with name %q already exists", kind.Create.Name))
+				break
+			}
+
+			v := validation.Treatment(kind.Create)
+			v.Name("name", kind.Create.Name)
+
+			t := TreatmentFromProto(kind.Create)
+			if err = validate(t, v); err == nil {
+				state[t.Name] = &t
+			}
+
+		case *treatmentv1alpha.TreatmentMutation_Update:
+			var cur *Treatment
+			cur, err = get(kind.Update.Name)
+			if err != nil {
+				return err
+			}
+
+			if cur == nil {
+				err = connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", kind.Update.Name))
+				break
+			}
+
+			var t Treatment
+			t, err = mergeTreatmentUpdate(*cur, kind.Update)
+			if err == nil {
+				err = validate(t, validation.TreatmentFields(t.ToProto(), kind.Update.GetUpdateMask().GetPaths()))
+			}
+
+			if err == nil {
+				state[t.Name] = &t
+			}
+
+		case *treatmentv1alpha.TreatmentMutation_Delete:
+			var cur *Treatment
+			cur, err = get(kind.Delete)
+			if err != nil {
+				return err
+			}
+
+			if cur == nil {
+				err = connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", kind.Delete))
+				break
+			}
+
+			state[kind.Delete] = nil
+
+		default:
+			err = connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("mutation does not specify an operation"))
+		}
+
+		if err != nil {
+			response.Results[idx].Error = err.Error()
+
+			if first == nil {
+				first = err
+			}
+		}
+	}
+
+	if first != nil {
+		return newBatchMutationError(first, response)
+	}
+
+	return nil
+}
+
+func newBatchMutationError(err error, response *treatmentv1alpha.BatchMutateTreatmentsResponse) error {
+	code := connect.CodeOf(err)
+	if code == connect.CodeUnknown {
+		code = connect.CodeAborted
+	}
+
+	cerr := connect.NewError(code, fmt.Errorf("batch mutation failed: %w", err))
+
+	if detail, derr := connect.NewErrorDetail(response); derr == nil {
+		cerr.AddDetail(detail)
+	}
+
+	return cerr
+}
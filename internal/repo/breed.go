@@ -0,0 +1,265 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *Repository) CreateBreed(ctx context.Context, b *treatmentv1alpha.Breed) (*treatmentv1alpha.Breed, error) {
+	ctx, end := observe(ctx, "CreateBreed")
+	defer end()
+
+	model := BreedFromProto(b)
+
+	if model.DisplayName == "" {
+		model.DisplayName = model.Name
+	}
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		if err := r.validateSpeciesExist(ctx, []string{model.Species}); err != nil {
+			return nil, err
+		}
+
+		if _, err := r.breeds.InsertOne(ctx, model); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("breed with name %q already exists", model.Name))
+			}
+
+			return nil, fmt.Errorf("failed to persist breed: %w", err)
+		}
+
+		return model.ToProto(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.Breed), nil
+}
+
+func (r *Repository) GetBreed(ctx context.Context, name string) (*treatmentv1alpha.Breed, error) {
+	ctx, end := observe(ctx, "GetBreed")
+	defer end()
+
+	res := r.breeds.FindOne(ctx, bson.M{"name": name})
+	if err := res.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("breed with name %q not found", name))
+		}
+
+		return nil, err
+	}
+
+	var m Breed
+	if err := res.Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to decode breed database model: %w", err)
+	}
+
+	return m.ToProto(), nil
+}
+
+func (r *Repository) ListBreeds(ctx context.Context, species string, attributes []string) ([]*treatmentv1alpha.Breed, error) {
+	ctx, end := observe(ctx, "ListBreeds")
+	defer end()
+
+	filter := bson.M{}
+
+	if species != "" {
+		filter["species"] = species
+	}
+
+	if len(attributes) > 0 {
+		filter["attributes"] = bson.M{
+			"$all": attributes,
+		}
+	}
+
+	breeds, err := r.findBreeds(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*treatmentv1alpha.Breed, len(breeds))
+	for idx, b := range breeds {
+		result[idx] = b.ToProto()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) UpdateBreed(ctx context.Context, upd *treatmentv1alpha.UpdateBreedRequest) (*treatmentv1alpha.Breed, error) {
+	ctx, end := observe(ctx, "UpdateBreed")
+	defer end()
+
+	paths := []string{"species", "display_name", "match_words", "icon", "attributes"}
+
+	if p := upd.GetUpdateMask().GetPaths(); len(p) > 0 {
+		paths = p
+	}
+
+	set := bson.M{}
+
+	for _, p := range paths {
+		switch p {
+		case "name":
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("breed name cannot be updated"))
+
+		case "species":
+			set["species"] = upd.Breed.Species
+
+		case "display_name":
+			set["displayName"] = upd.Breed.DisplayName
+
+		case "match_words":
+			set["matchWords"] = upd.Breed.MatchWords
+
+		case "icon":
+			if upd.Breed.Icon != nil {
+				set["iconData"] = upd.Breed.Icon.Data
+				set["iconType"] = uint8(upd.Breed.Icon.Type)
+			} else {
+				set["iconData"] = []byte(nil)
+				set["iconType"] = uint8(0)
+			}
+
+		case "attributes":
+			set["attributes"] = upd.Breed.Attributes
+
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid message field path %q", p))
+		}
+	}
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		if species, ok := set["species"].(string); ok {
+			if err := r.validateSpeciesExist(ctx, []string{species}); err != nil {
+				return nil, err
+			}
+		}
+
+		res := r.breeds.FindOneAndUpdate(ctx, bson.M{"name": upd.Name}, bson.M{
+			"$set": set,
+		}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+		if err := res.Err(); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("breed with name %q not found", upd.Name))
+			}
+
+			return nil, err
+		}
+
+		var m Breed
+		if err := res.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to decode breed database model: %w", err)
+		}
+
+		return m.ToProto(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.Breed), nil
+}
+
+func (r *Repository) DeleteBreed(ctx context.Context, name string) error {
+	ctx, end := observe(ctx, "DeleteBreed")
+	defer end()
+
+	res, err := r.breeds.DeleteOne(ctx, bson.M{"name": name})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("breed with name %q not found", name))
+	}
+
+	return nil
+}
+
+// DetectBreeds detects breeds in the given values the same way DetectSpecies
+// detects species.
+func (r *Repository) DetectBreeds(ctx context.Context, req *treatmentv1alpha.DetectBreedsRequest, opts textmatch.Options) (*treatmentv1alpha.DetectBreedsResponse, error) {
+	ctx, end := observe(ctx, "DetectBreeds")
+	defer end()
+
+	filter := bson.M{}
+	if req.Species != "" {
+		filter["species"] = req.Species
+	}
+
+	breeds, err := r.findBreeds(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[string]*textmatch.Score)
+
+	for _, v := range req.Values {
+		text := textmatch.NewText(v)
+
+		for _, b := range breeds {
+			for _, m := range b.MatchWords {
+				match, ok := text.Match(m, opts)
+				if !ok {
+					continue
+				}
+
+				if _, ok := scores[b.Name]; !ok {
+					scores[b.Name] = new(textmatch.Score)
+				}
+
+				scores[b.Name].Add(match)
+			}
+		}
+	}
+
+	// collect in the order of the breed list so the result is stable
+	// for breeds with an equal score.
+	matches := make([]Breed, 0, len(scores))
+	for _, b := range breeds {
+		if _, ok := scores[b.Name]; ok {
+			matches = append(matches, b)
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b Breed) int {
+		return scores[b.Name].Compare(*scores[a.Name])
+	})
+
+	response := &treatmentv1alpha.DetectBreedsResponse{}
+	for _, b := range matches {
+		response.Breeds = append(response.Breeds, b.ToProto())
+
+		if !slices.Contains(response.Species, b.Species) {
+			response.Species = append(response.Species, b.Species)
+		}
+	}
+
+	return response, nil
+}
+
+func (r *Repository) findBreeds(ctx context.Context, filter bson.M) ([]Breed, error) {
+	res, err := r.breeds.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform find operation: %w", err)
+	}
+
+	var breeds []Breed
+	if err := res.All(ctx, &breeds); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more breed database models: %w", err)
+	}
+
+	return breeds, nil
+}
@@ -0,0 +1,431 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (r *Repository) CreateBundle(ctx context.Context, b *treatmentv1alpha.Bundle) (*treatmentv1alpha.Bundle, error) {
+	ctx, end := observe(ctx, "CreateBundle")
+	defer end()
+
+	model := BundleFromProto(b)
+
+	if model.DisplayName == "" {
+		model.DisplayName = model.Name
+	}
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		members, err := r.bundleMembers(ctx, model)
+		if err != nil {
+			return nil, err
+		}
+
+		effective, err := computeBundleConstraints(model, members)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := r.bundles.InsertOne(ctx, model); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("bundle with name %q already exists", model.Name))
+			}
+
+			return nil, fmt.Errorf("failed to persist bundle: %w", err)
+		}
+
+		res := model.ToProto()
+		res.Effective = effective
+
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.Bundle), nil
+}
+
+func (r *Repository) GetBundle(ctx context.Context, name string) (*treatmentv1alpha.Bundle, error) {
+	ctx, end := observe(ctx, "GetBundle")
+	defer end()
+
+	res := r.bundles.FindOne(ctx, bson.M{"name": name})
+	if err := res.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("bundle with name %q not found", name))
+		}
+
+		return nil, err
+	}
+
+	var m Bundle
+	if err := res.Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to decode bundle database model: %w", err)
+	}
+
+	return r.bundleToProto(ctx, m, false)
+}
+
+func (r *Repository) ListBundles(ctx context.Context, treatment string) ([]*treatmentv1alpha.Bundle, error) {
+	ctx, end := observe(ctx, "ListBundles")
+	defer end()
+
+	filter := bson.M{}
+
+	if treatment != "" {
+		filter["treatments"] = treatment
+	}
+
+	res, err := r.bundles.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform find operation: %w", err)
+	}
+
+	var m []Bundle
+	if err := res.All(ctx, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more bundle database models: %w", err)
+	}
+
+	result := make([]*treatmentv1alpha.Bundle, len(m))
+	for idx, b := range m {
+		result[idx], err = r.bundleToProto(ctx, b, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Repository) UpdateBundle(ctx context.Context, upd *treatmentv1alpha.UpdateBundleRequest) (*treatmentv1alpha.Bundle, error) {
+	ctx, end := observe(ctx, "UpdateBundle")
+	defer end()
+
+	paths := []string{
+		"display_name",
+		"help_text",
+		"treatments",
+		"duration",
+		"allow_self_booking",
+	}
+
+	if p := upd.GetUpdateMask().GetPaths(); len(p) > 0 {
+		paths = p
+	}
+
+	set := bson.M{}
+
+	for _, p := range paths {
+		switch p {
+		case "name":
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bundle name cannot be updated"))
+
+		case "display_name":
+			set["displayName"] = upd.Bundle.DisplayName
+
+		case "help_text":
+			set["helpText"] = upd.Bundle.HelpText
+
+		case "treatments":
+			set["treatments"] = upd.Bundle.Treatments
+
+		case "duration":
+			set["duration"] = upd.Bundle.Duration.AsDuration()
+
+		case "allow_self_booking":
+			set["allowSelfBooking"] = upd.Bundle.AllowSelfBooking
+
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid message field path %q", p))
+		}
+	}
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		res := r.bundles.FindOneAndUpdate(ctx, bson.M{"name": upd.Name}, bson.M{
+			"$set": set,
+		}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+		if err := res.Err(); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("bundle with name %q not found", upd.Name))
+			}
+
+			return nil, err
+		}
+
+		var m Bundle
+		if err := res.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to decode bundle database model: %w", err)
+		}
+
+		// validate the updated bundle, returning an error here will
+		// abort the transaction.
+		return r.bundleToProto(ctx, m, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.Bundle), nil
+}
+
+func (r *Repository) DeleteBundle(ctx context.Context, name string) error {
+	ctx, end := observe(ctx, "DeleteBundle")
+	defer end()
+
+	res, err := r.bundles.DeleteOne(ctx, bson.M{"name": name})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("bundle with name %q not found", name))
+	}
+
+	return nil
+}
+
+// minBundleMembers is the minimum number of treatments of a bundle.
+const minBundleMembers = 2
+
+// bundleMemberRemoval returns the names of all bundles that are modified or
+// deleted when the given treatments are removed. Bundles that are left with
+// less than minBundleMembers treatments are deleted.
+func (r *Repository) bundleMemberRemoval(ctx context.Context, names []string) (modified []string, deleted []string, err error) {
+	res, err := r.bundles.Find(ctx, bson.M{"treatments": bson.M{"$in": names}})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find bundles: %w", err)
+	}
+
+	var bundles []Bundle
+	if err := res.All(ctx, &bundles); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode one or more bundle database models: %w", err)
+	}
+
+	for _, b := range bundles {
+		remaining := slices.DeleteFunc(slices.Clone(b.Treatments), func(t string) bool {
+			return slices.Contains(names, t)
+		})
+
+		if len(remaining) < minBundleMembers {
+			deleted = append(deleted, b.Name)
+		} else {
+			modified = append(modified, b.Name)
+		}
+	}
+
+	return modified, deleted, nil
+}
+
+// removeBundleMembers removes the given treatments from all bundles and
+// returns the names of the modified and deleted bundles. The duration
+// override of modified bundles is cleared since it has been set for a
+// different set of treatments. Bundles that are left with less than
+// minBundleMembers treatments are deleted.
+func (r *Repository) removeBundleMembers(ctx context.Context, names []string) (modified []string, deleted []string, err error) {
+	modified, deleted, err = r.bundleMemberRemoval(ctx, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(deleted) > 0 {
+		if _, err := r.bundles.DeleteMany(ctx, bson.M{"name": bson.M{"$in": deleted}}); err != nil {
+			return nil, nil, fmt.Errorf("failed to delete bundles: %w", err)
+		}
+	}
+
+	if len(modified) > 0 {
+		if _, err := r.bundles.UpdateMany(
+			ctx,
+			bson.M{"name": bson.M{"$in": modified}},
+			bson.M{
+				"$pull": bson.M{
+					"treatments": bson.M{"$in": names},
+				},
+				"$set": bson.M{
+					"duration": time.Duration(0),
+				},
+			},
+		); err != nil {
+			return nil, nil, fmt.Errorf("failed to remove treatments from bundles: %w", err)
+		}
+	}
+
+	return modified, deleted, nil
+}
+
+// bundleToProto converts b to its protobuf representation including the
+// effective constraints. If strict is false, constraint violations (for
+// example, if member treatments have been updated in the meantime) are
+// ignored and the effective constraints are left unset.
+func (r *Repository) bundleToProto(ctx context.Context, b Bundle, strict bool) (*treatmentv1alpha.Bundle, error) {
+	res := b.ToProto()
+
+	members, err := r.bundleMembers(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Effective, err = computeBundleConstraints(b, members)
+	if err != nil && strict {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// bundleMembers returns all member treatments of b in the order they
+// are listed in the bundle. Species groups of the members are expanded
+// to all descendants.
+func (r *Repository) bundleMembers(ctx context.Context, b Bundle) ([]Treatment, error) {
+	res, err := r.treatments.Find(ctx, bson.M{
+		"name": bson.M{
+			"$in": b.Treatments,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bundle treatments: %w", err)
+	}
+
+	var ts []Treatment
+	if err := res.All(ctx, &ts); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more treatment database models: %w", err)
+	}
+
+	tree, err := r.loadSpeciesTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Treatment, 0, len(b.Treatments))
+	for _, name := range b.Treatments {
+		idx := slices.IndexFunc(ts, func(t Treatment) bool { return t.Name == name })
+		if idx < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("treatment %q not found", name))
+		}
+
+		// species groups are expanded so the species of members
+		// can be intersected
+		t := ts[idx]
+		t.Species = tree.Expand(t.Species)
+
+		members = append(members, t)
+	}
+
+	return members, nil
+}
+
+// computeBundleConstraints computes the effective constraints of a bundle and
+// returns an error if the member treatments cannot be booked together.
+func computeBundleConstraints(b Bundle, members []Treatment) (*treatmentv1alpha.BundleConstraints, error) {
+	if len(members) < minBundleMembers {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a bundle must contain at least %d treatments", minBundleMembers))
+	}
+
+	var (
+		longest          Treatment
+		additional       time.Duration
+		species          []string
+		allowed          []string
+		preferred        []string
+		resources        []string
+		allowSelfBooking = b.AllowSelfBooking
+		seen             = make(map[string]struct{}, len(members))
+	)
+
+	for _, t := range members {
+		if _, ok := seen[t.Name]; ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("treatment %q is listed more than once", t.Name))
+		}
+		seen[t.Name] = struct{}{}
+
+		if t.Template {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("treatment %q is a template and cannot be booked", t.Name))
+		}
+
+		// the highest initial time requirement is used and the additional time
+		// requirement for all others.
+		additional += t.AdditionalTimeRequirement
+		if t.InitialTimeRequirement >= longest.InitialTimeRequirement {
+			longest = t
+		}
+
+		species = intersectRestrictions(species, t.Species)
+		if species != nil && len(species) == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the treatments of the bundle do not share a common species"))
+		}
+
+		allowed = intersectRestrictions(allowed, t.AllowedEmployees)
+		if allowed != nil && len(allowed) == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no employee is allowed to handle all treatments of the bundle"))
+		}
+
+		for _, p := range t.PreferredEmployees {
+			if !slices.Contains(preferred, p) {
+				preferred = append(preferred, p)
+			}
+		}
+
+		for _, res := range t.Resources {
+			if !slices.Contains(resources, res) {
+				resources = append(resources, res)
+			}
+		}
+
+		if !t.AllowSelfBooking {
+			allowSelfBooking = false
+		}
+	}
+
+	if allowed != nil {
+		preferred = slices.DeleteFunc(preferred, func(p string) bool {
+			return !slices.Contains(allowed, p)
+		})
+	}
+
+	duration := longest.InitialTimeRequirement + additional - longest.AdditionalTimeRequirement
+	if b.Duration > 0 {
+		duration = b.Duration
+	}
+
+	return &treatmentv1alpha.BundleConstraints{
+		Duration:           durationpb.New(duration),
+		Species:            species,
+		AllowedEmployees:   allowed,
+		PreferredEmployees: preferred,
+		Resources:          resources,
+		AllowSelfBooking:   allowSelfBooking,
+	}, nil
+}
+
+// intersectRestrictions intersects two lists where an empty (nil) list means
+// "no restriction". The result is nil if neither a nor b restrict and non-nil
+// (but maybe empty) otherwise.
+func intersectRestrictions(a, b []string) []string {
+	switch {
+	case len(b) == 0:
+		return a
+	case a == nil:
+		return slices.Clone(b)
+	}
+
+	result := []string{}
+	for _, v := range a {
+		if slices.Contains(b, v) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
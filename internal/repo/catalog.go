@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SelfBookingCatalog returns all species with the treatments that are
+// available for self-booking. Species without any bookable treatment
+// are omitted.
+func (r *Repository) SelfBookingCatalog(ctx context.Context) (*treatmentv1alpha.SelfBookingCatalog, error) {
+	ctx, end := observe(ctx, "SelfBookingCatalog")
+	defer end()
+
+	species, err := r.ListSpecies(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	treatments, err := r.findTreatments(ctx, bson.M{
+		"allowSelfBooking": true,
+		"template":         bson.M{"$ne": true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := r.loadSpeciesTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	prices, err := r.findPrices(ctx, bson.M{
+		"validFrom": bson.M{
+			"$lte": now,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := &treatmentv1alpha.SelfBookingCatalog{}
+
+	for _, s := range species {
+		cs := &treatmentv1alpha.CatalogSpecies{
+			Name:                    s.Name,
+			DisplayName:             s.DisplayName,
+			RequestCastrationStatus: s.RequestCastrationStatus,
+			Icon:                    s.Icon,
+		}
+
+		for _, t := range treatments {
+			// treatments without species apply to all species
+			if len(t.Species) > 0 && !tree.Includes(t.Species, s.Name) {
+				continue
+			}
+
+			ct := &treatmentv1alpha.CatalogTreatment{
+				Name:                      t.Name,
+				DisplayName:               t.DisplayName,
+				HelpText:                  t.HelpText,
+				InitialTimeRequirement:    t.InitialTimeRequirement,
+				AdditionalTimeRequirement: t.AdditionalTimeRequirement,
+			}
+
+			// prices are only exposed if they have been published
+			if p, ok := effectivePrice(prices, t.Name, s.Name, now); ok && p.Published {
+				ct.Price = p.ToProto()
+			}
+
+			cs.Treatments = append(cs.Treatments, ct)
+		}
+
+		if len(cs.Treatments) > 0 {
+			catalog.Species = append(catalog.Species, cs)
+		}
+	}
+
+	return catalog, nil
+}
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/validation"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CloneTreatment copies a treatment including all details under a new name
+// and applies the overrides selected by req.OverrideMask.
+func (r *Repository) CloneTreatment(ctx context.Context, req *treatmentv1alpha.CloneTreatmentRequest) (*treatmentv1alpha.TreatmentWithDetails, error) {
+	ctx, end := observe(ctx, "CloneTreatment")
+	defer end()
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		t, err := r.findTreatment(ctx, req.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if paths := req.GetOverrideMask().GetPaths(); len(paths) > 0 {
+			o := req.Overrides
+			if o == nil {
+				o = new(treatmentv1.Treatment)
+			}
+
+			t, err = mergeTreatmentUpdate(t, &treatmentv1.UpdateTreatmentRequest{
+				Name:                      req.Name,
+				DisplayName:               o.DisplayName,
+				HelpText:                  o.HelpText,
+				Species:                   o.Species,
+				AllowSelfBooking:          o.AllowSelfBooking,
+				InitialTimeRequirement:    o.InitialTimeRequirement,
+				AdditionalTimeRequirement: o.AdditionalTimeRequirement,
+				AllowedEmployees:          o.AllowedEmployees,
+				MatchEventText:            o.MatchEventText,
+				PreferredEmployees:        o.PreferredEmployees,
+				Resources:                 o.Resources,
+				UpdateMask:                req.OverrideMask,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		t.Name = req.NewName
+		t.Template = req.Template
+
+		v := validation.Treatment(t.ToProto())
+		v.Name("new_name", req.NewName)
+		if err := v.Err(); err != nil {
+			return nil, err
+		}
+
+		if len(t.Species) > 0 {
+			if err := r.validateSpeciesExist(ctx, t.Species); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := r.treatments.InsertOne(ctx, t); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("treatment with name %q already exists", t.Name))
+			}
+
+			return nil, fmt.Errorf("failed to persist treatment: %w", err)
+		}
+
+		return &treatmentv1alpha.TreatmentWithDetails{
+			Treatment: t.ToProto(),
+			Details:   t.DetailsToProto(),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.TreatmentWithDetails), nil
+}
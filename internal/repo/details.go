@@ -0,0 +1,491 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/instructions"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/validation"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *Repository) GetTreatmentDetails(ctx context.Context, name string) (*treatmentv1alpha.TreatmentDetails, error) {
+	ctx, end := observe(ctx, "GetTreatmentDetails")
+	defer end()
+
+	t, err := r.findTreatment(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.DetailsToProto(), nil
+}
+
+func (r *Repository) ListTreatmentsWithDetails(ctx context.Context, req *treatmentv1alpha.ListTreatmentsWithDetailsRequest) ([]*treatmentv1alpha.TreatmentWithDetails, error) {
+	ctx, end := observe(ctx, "ListTreatmentsWithDetails")
+	defer end()
+
+	var species []string
+	if req.Species != "" {
+		species = []string{req.Species}
+	}
+
+	all, err := r.queryTreatments(ctx, species, req.DisplayNameSearch, req.IncludeTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	sex := req.GetPatient().GetSex()
+	castration := req.GetPatient().GetCastrationStatus()
+
+	// the castration status is only relevant if the species asks for it
+	if req.Species != "" && castration != treatmentv1alpha.CastrationStatus_CASTRATION_STATUS_UNSPECIFIED {
+		s, err := r.GetSpecies(ctx, req.Species)
+		if err != nil {
+			return nil, err
+		}
+
+		if !s.RequestCastrationStatus {
+			castration = treatmentv1alpha.CastrationStatus_CASTRATION_STATUS_UNSPECIFIED
+		}
+	}
+
+	var result []*treatmentv1alpha.TreatmentWithDetails
+	for _, t := range all {
+		if !t.IsEligible(sex, castration) {
+			continue
+		}
+
+		switch req.Anesthesia {
+		case treatmentv1alpha.AnesthesiaFilter_ANESTHESIA_FILTER_REQUIRED:
+			if !t.RequiresAnesthesia() {
+				continue
+			}
+		case treatmentv1alpha.AnesthesiaFilter_ANESTHESIA_FILTER_NOT_REQUIRED:
+			if t.RequiresAnesthesia() {
+				continue
+			}
+		}
+
+		if req.StartTime != nil && !t.MayStartAt(req.StartTime.AsDuration()) {
+			continue
+		}
+
+		result = append(result, &treatmentv1alpha.TreatmentWithDetails{
+			Treatment: t.ToProto(),
+			Details:   t.DetailsToProto(),
+		})
+	}
+
+	return result, nil
+}
+
+func (r *Repository) UpdateTreatmentDetails(ctx context.Context, upd *treatmentv1alpha.UpdateTreatmentDetailsRequest) (*treatmentv1alpha.TreatmentDetails, error) {
+	ctx, end := observe(ctx, "UpdateTreatmentDetails")
+	defer end()
+
+	paths := []string{
+		"prerequisites",
+		"preparation_instructions",
+		"species_preparation_instructions",
+		"pre_op_requirements",
+		"eligibility",
+		"template",
+	}
+
+	if p := upd.GetUpdateMask().GetPaths(); len(p) > 0 {
+		paths = p
+	}
+
+	set := bson.M{}
+	v := new(validation.Violations)
+
+	for _, p := range paths {
+		switch p {
+		case "name":
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("treatment name cannot be updated"))
+
+		case "prerequisites":
+			prerequisites := make([]Prerequisite, len(upd.Details.Prerequisites))
+			for idx, p := range upd.Details.Prerequisites {
+				prerequisites[idx] = PrerequisiteFromProto(p)
+			}
+
+			set["prerequisites"] = prerequisites
+
+		case "preparation_instructions":
+			validateInstructions("preparation_instructions", upd.Details.PreparationInstructions, v)
+
+			set["preparationInstructions"] = upd.Details.PreparationInstructions
+
+		case "species_preparation_instructions":
+			overwrites := make([]SpeciesPreparationInstructions, len(upd.Details.SpeciesPreparationInstructions))
+			for idx, s := range upd.Details.SpeciesPreparationInstructions {
+				field := fmt.Sprintf("species_preparation_instructions[%d]", idx)
+
+				if slices.ContainsFunc(overwrites[:idx], func(o SpeciesPreparationInstructions) bool { return o.Species == s.Species }) {
+					v.Add(field+".species", "preparation instructions for species %q are defined more than once", s.Species)
+				}
+
+				validateInstructions(field+".instructions", s.Instructions, v)
+
+				overwrites[idx] = SpeciesPreparationInstructions{
+					Species:      s.Species,
+					Instructions: s.Instructions,
+				}
+			}
+
+			set["speciesPreparationInstructions"] = overwrites
+
+		case "pre_op_requirements":
+			if upd.Details.PreOpRequirements == nil {
+				set["preOpRequirements"] = nil
+				break
+			}
+
+			req := PreOpRequirementsFromProto(upd.Details.PreOpRequirements)
+			validatePreOpRequirements("pre_op_requirements", req, v)
+
+			set["preOpRequirements"] = req
+
+		case "eligibility":
+			if upd.Details.Eligibility == nil {
+				set["eligibility"] = nil
+				break
+			}
+
+			set["eligibility"] = EligibilityFromProto(upd.Details.Eligibility)
+
+		case "template":
+			set["template"] = upd.Details.Template
+
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid message field path %q", p))
+		}
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	result, err := r.withTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+		if prerequisites, ok := set["prerequisites"].([]Prerequisite); ok {
+			if err := r.validatePrerequisites(sc, upd.Name, prerequisites); err != nil {
+				return nil, err
+			}
+		}
+
+		if overwrites, ok := set["speciesPreparationInstructions"].([]SpeciesPreparationInstructions); ok && len(overwrites) > 0 {
+			species := make([]string, len(overwrites))
+			for idx, o := range overwrites {
+				species[idx] = o.Species
+			}
+
+			if err := r.validateSpeciesExist(sc, species); err != nil {
+				return nil, err
+			}
+		}
+
+		if template, ok := set["template"].(bool); ok && template {
+			bundles, err := r.bundles.CountDocuments(sc, bson.M{"treatments": upd.Name})
+			if err != nil {
+				return nil, fmt.Errorf("failed to count bundles: %w", err)
+			}
+
+			if bundles > 0 {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("treatment %q is part of %d bundles and cannot be turned into a template", upd.Name, bundles))
+			}
+		}
+
+		res := r.treatments.FindOneAndUpdate(sc, bson.M{"name": upd.Name}, bson.M{
+			"$set": set,
+		}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+		if err := res.Err(); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", upd.Name))
+			}
+
+			return nil, err
+		}
+
+		var m Treatment
+		if err := res.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to decode treatment database model: %w", err)
+		}
+
+		return m.DetailsToProto(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.TreatmentDetails), nil
+}
+
+func (r *Repository) CheckPrerequisites(ctx context.Context, req *treatmentv1alpha.CheckPrerequisitesRequest) (*treatmentv1alpha.CheckPrerequisitesResponse, error) {
+	ctx, end := observe(ctx, "CheckPrerequisites")
+	defer end()
+
+	t, err := r.findTreatment(ctx, req.Treatment)
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.Now()
+	if req.Time != nil {
+		at = req.Time.AsTime()
+	}
+
+	response := &treatmentv1alpha.CheckPrerequisitesResponse{
+		Satisfied: true,
+	}
+
+	for _, p := range t.Prerequisites {
+		result := &treatmentv1alpha.PrerequisiteResult{
+			Prerequisite: p.ToProto(),
+		}
+
+		for _, h := range req.History {
+			if !slices.Contains(p.Treatments, h.Treatment) {
+				continue
+			}
+
+			performed := h.Time.AsTime()
+
+			// treatments in the future cannot satisfy a prerequisite
+			if performed.After(at) {
+				continue
+			}
+
+			if p.MaxAge > 0 && at.Sub(performed) > p.MaxAge {
+				continue
+			}
+
+			// prefer the most recent treatment
+			if result.SatisfiedBy == nil || performed.After(result.SatisfiedBy.Time.AsTime()) {
+				result.SatisfiedBy = h
+			}
+		}
+
+		result.Satisfied = result.SatisfiedBy != nil
+		if !result.Satisfied {
+			response.Satisfied = false
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+// RenderInstructions renders the preparation instructions of all requested
+// treatments.
+func (r *Repository) RenderInstructions(ctx context.Context, req *treatmentv1alpha.RenderInstructionsRequest) (*treatmentv1alpha.RenderInstructionsResponse, error) {
+	ctx, end := observe(ctx, "RenderInstructions")
+	defer end()
+
+	c := instructions.Context{
+		PetName: req.PetName,
+	}
+
+	if req.AppointmentTime != nil {
+		loc := time.Local
+		if req.TimeZone != "" {
+			var err error
+			loc, err = time.LoadLocation(req.TimeZone)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid time zone %q: %w", req.TimeZone, err))
+			}
+		}
+
+		c.AppointmentTime = req.AppointmentTime.AsTime().In(loc)
+	}
+
+	response := &treatmentv1alpha.RenderInstructionsResponse{}
+
+	for _, name := range req.Treatments {
+		t, err := r.findTreatment(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &treatmentv1alpha.RenderedInstructions{
+			Treatment: t.Name,
+		}
+
+		for _, i := range t.InstructionsFor(req.Species) {
+			rendered, missing := instructions.Render(i, c)
+
+			for _, m := range missing {
+				if !slices.Contains(response.MissingPlaceholders, m) {
+					response.MissingPlaceholders = append(response.MissingPlaceholders, m)
+				}
+			}
+
+			result.Instructions = append(result.Instructions, rendered)
+
+			if !slices.Contains(response.Instructions, rendered) {
+				response.Instructions = append(response.Instructions, rendered)
+			}
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+func validatePreOpRequirements(field string, req PreOpRequirements, v *validation.Violations) {
+	if req.FastingDuration < 0 {
+		v.Add(field+".fasting_duration", "must not be negative")
+	}
+
+	if req.RecoveryTime < 0 {
+		v.Add(field+".recovery_time", "must not be negative")
+	}
+
+	if req.LatestStart != nil && (*req.LatestStart < 0 || *req.LatestStart >= 24*time.Hour) {
+		v.Add(field+".latest_start", "must be a valid time of day")
+	}
+}
+
+func validateInstructions(field string, list []string, v *validation.Violations) {
+	for idx, i := range list {
+		if err := instructions.Validate(i); err != nil {
+			v.Add(fmt.Sprintf("%s[%d]", field, idx), "invalid preparation instruction %q: %s", i, err)
+		}
+	}
+}
+
+func (r *Repository) validatePrerequisites(ctx context.Context, name string, prerequisites []Prerequisite) error {
+	v := new(validation.Violations)
+
+	var names []string
+	for _, p := range prerequisites {
+		names = append(names, p.Treatments...)
+	}
+
+	existing := make(map[string]struct{})
+	if len(names) > 0 {
+		values, err := r.treatments.Distinct(ctx, "name", bson.M{
+			"name": bson.M{
+				"$in": names,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to validate prerequisites: %w", err)
+		}
+
+		for _, value := range values {
+			if s, ok := value.(string); ok {
+				existing[s] = struct{}{}
+			}
+		}
+	}
+
+	for idx, p := range prerequisites {
+		field := fmt.Sprintf("prerequisites[%d]", idx)
+
+		if len(p.Treatments) == 0 {
+			v.Add(field+".treatments", "prerequisite does not reference any treatment")
+		}
+
+		if p.MaxAge < 0 {
+			v.Add(field+".max_age", "must not be negative")
+		}
+
+		for tidx, t := range p.Treatments {
+			tfield := fmt.Sprintf("%s.treatments[%d]", field, tidx)
+
+			if t == name {
+				v.Add(tfield, "treatment %q cannot be a prerequisite of itself", name)
+				continue
+			}
+
+			if _, ok := existing[t]; !ok {
+				v.Add(tfield, "treatment %q not found", t)
+			}
+		}
+	}
+
+	return v.Err()
+}
+
+// removeTreatmentReferences removes all references to the given treatments
+// from the prerequisites of other treatments and from bundles. Prerequisites
+// that do not reference any treatment afterwards are removed as well, see
+// removeBundleMembers for bundles. Prices of the treatments are deleted.
+func (r *Repository) removeTreatmentReferences(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	if _, err := r.treatments.UpdateMany(
+		ctx,
+		bson.M{"prerequisites.treatments": bson.M{"$in": names}},
+		bson.M{
+			"$pull": bson.M{
+				"prerequisites.$[p].treatments": bson.M{"$in": names},
+			},
+		},
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []any{bson.M{"p.treatments": bson.M{"$in": names}}},
+		}),
+	); err != nil {
+		return fmt.Errorf("failed to remove treatments from prerequisites: %w", err)
+	}
+
+	if _, err := r.treatments.UpdateMany(
+		ctx,
+		bson.M{"prerequisites.treatments": bson.M{"$size": 0}},
+		bson.M{
+			"$pull": bson.M{
+				"prerequisites": bson.M{"treatments": bson.M{"$size": 0}},
+			},
+		},
+	); err != nil {
+		return fmt.Errorf("failed to remove empty prerequisites: %w", err)
+	}
+
+	modifiedBundles, deletedBundles, err := r.removeBundleMembers(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	if len(modifiedBundles) > 0 || len(deletedBundles) > 0 {
+		slog.InfoContext(ctx, "removed deleted treatments from bundles", "treatments", names, "modified", modifiedBundles, "deleted", deletedBundles)
+	}
+
+	if _, err := r.prices.DeleteMany(ctx, bson.M{"treatment": bson.M{"$in": names}}); err != nil {
+		return fmt.Errorf("failed to delete treatment prices: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) findTreatment(ctx context.Context, name string) (Treatment, error) {
+	res := r.treatments.FindOne(ctx, bson.M{"name": name})
+	if err := res.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return Treatment{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", name))
+		}
+
+		return Treatment{}, err
+	}
+
+	var t Treatment
+	if err := res.Decode(&t); err != nil {
+		return Treatment{}, fmt.Errorf("failed to decode treatment database model: %w", err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,184 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCheckPrerequisites(t *testing.T) {
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+				t.Fatalf("failed to create species: %s", err)
+			}
+
+			for _, name := range []string{"vaccination", "checkup", "booster", "dental"} {
+				if _, err := r.CreateTreatment(ctx, &treatmentv1.Treatment{Name: name, Species: []string{"dog"}}); err != nil {
+					t.Fatalf("failed to create treatment %q: %s", name, err)
+				}
+			}
+
+			if _, err := r.UpdateTreatmentDetails(ctx, &treatmentv1alpha.UpdateTreatmentDetailsRequest{
+				Name: "booster",
+				Details: &treatmentv1alpha.TreatmentDetails{
+					Prerequisites: []*treatmentv1alpha.Prerequisite{
+						{Treatments: []string{"vaccination", "checkup"}, MaxAge: durationpb.New(30 * 24 * time.Hour)},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"prerequisites"}},
+			}); err != nil {
+				t.Fatalf("failed to set prerequisites: %s", err)
+			}
+
+			at := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+			past := func(treatment string, age time.Duration) *treatmentv1alpha.PastTreatment {
+				return &treatmentv1alpha.PastTreatment{Treatment: treatment, Time: timestamppb.New(at.Add(-age))}
+			}
+
+			cases := []struct {
+				name      string
+				treatment string
+				history   []*treatmentv1alpha.PastTreatment
+				satisfied bool
+				by        time.Duration
+			}{
+				{"no history", "booster", nil, false, 0},
+				{"satisfied", "booster", []*treatmentv1alpha.PastTreatment{past("vaccination", 24*time.Hour)}, true, 24 * time.Hour},
+				{"any listed treatment", "booster", []*treatmentv1alpha.PastTreatment{past("checkup", 48*time.Hour)}, true, 48 * time.Hour},
+				{"unrelated treatment", "booster", []*treatmentv1alpha.PastTreatment{past("dental", time.Hour)}, false, 0},
+				{"too old", "booster", []*treatmentv1alpha.PastTreatment{past("vaccination", 31*24*time.Hour)}, false, 0},
+				{"in the future", "booster", []*treatmentv1alpha.PastTreatment{past("vaccination", -time.Hour)}, false, 0},
+				{"most recent", "booster", []*treatmentv1alpha.PastTreatment{
+					past("vaccination", 10*24*time.Hour),
+					past("checkup", 2*time.Hour),
+					past("vaccination", 40*24*time.Hour),
+				}, true, 2 * time.Hour},
+				{"without prerequisites", "dental", nil, true, 0},
+			}
+
+			for _, c := range cases {
+				t.Run(c.name, func(t *testing.T) {
+					res, err := r.CheckPrerequisites(ctx, &treatmentv1alpha.CheckPrerequisitesRequest{
+						Treatment: c.treatment,
+						History:   c.history,
+						Time:      timestamppb.New(at),
+					})
+					if err != nil {
+						t.Fatalf("failed to check prerequisites: %s", err)
+					}
+
+					if res.Satisfied != c.satisfied {
+						t.Errorf("expected satisfied=%t but got %t", c.satisfied, res.Satisfied)
+					}
+
+					if c.by == 0 {
+						return
+					}
+
+					if got := res.GetResults()[0].GetSatisfiedBy().GetTime().AsTime(); !got.Equal(at.Add(-c.by)) {
+						t.Errorf("expected the prerequisite to be satisfied by the treatment at %s but got %s", at.Add(-c.by), got)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestRemoveTreatmentReferences(t *testing.T) {
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+				t.Fatalf("failed to create species: %s", err)
+			}
+
+			for _, name := range []string{"vaccination", "checkup", "deworming", "booster"} {
+				if _, err := r.CreateTreatment(ctx, &treatmentv1.Treatment{Name: name, Species: []string{"dog"}}); err != nil {
+					t.Fatalf("failed to create treatment %q: %s", name, err)
+				}
+			}
+
+			if _, err := r.UpdateTreatmentDetails(ctx, &treatmentv1alpha.UpdateTreatmentDetailsRequest{
+				Name: "booster",
+				Details: &treatmentv1alpha.TreatmentDetails{
+					Prerequisites: []*treatmentv1alpha.Prerequisite{
+						{Treatments: []string{"vaccination", "checkup"}},
+						{Treatments: []string{"vaccination"}},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"prerequisites"}},
+			}); err != nil {
+				t.Fatalf("failed to set prerequisites: %s", err)
+			}
+
+			bundles := []*treatmentv1alpha.Bundle{
+				{Name: "pair", Treatments: []string{"vaccination", "checkup"}},
+				{Name: "triple", Treatments: []string{"vaccination", "checkup", "deworming"}, Duration: durationpb.New(time.Hour)},
+			}
+			for _, b := range bundles {
+				if _, err := r.CreateBundle(ctx, b); err != nil {
+					t.Fatalf("failed to create bundle %q: %s", b.Name, err)
+				}
+			}
+
+			for _, name := range []string{"vaccination", "checkup"} {
+				if _, err := r.CreatePrice(ctx, &treatmentv1alpha.Price{Treatment: name, ValidFrom: timestamppb.New(time.Now()), MinNet: 1000}); err != nil {
+					t.Fatalf("failed to create price for %q: %s", name, err)
+				}
+			}
+
+			if err := r.DeleteTreatment(ctx, "vaccination"); err != nil {
+				t.Fatalf("failed to delete treatment: %s", err)
+			}
+
+			details, err := r.GetTreatmentDetails(ctx, "booster")
+			if err != nil {
+				t.Fatalf("failed to get treatment details: %s", err)
+			}
+
+			// the second prerequisite does not reference any treatment anymore
+			if got := details.GetPrerequisites(); len(got) != 1 || !slices.Equal(got[0].GetTreatments(), []string{"checkup"}) {
+				t.Errorf("expected a single prerequisite on %q but got %v", "checkup", got)
+			}
+
+			if _, err := r.GetBundle(ctx, "pair"); err == nil {
+				t.Errorf("expected the bundle left with a single treatment to be deleted")
+			}
+
+			triple, err := r.GetBundle(ctx, "triple")
+			if err != nil {
+				t.Fatalf("failed to get bundle: %s", err)
+			}
+
+			if got := triple.GetTreatments(); !slices.Equal(got, []string{"checkup", "deworming"}) {
+				t.Errorf("expected the bundle to contain the remaining treatments but got %v", got)
+			}
+
+			if triple.GetDuration().AsDuration() != 0 {
+				t.Errorf("expected the duration override to be cleared but got %s", triple.GetDuration().AsDuration())
+			}
+
+			prices, err := r.ListPrices(ctx, "", "")
+			if err != nil {
+				t.Fatalf("failed to list prices: %s", err)
+			}
+
+			if len(prices) != 1 || prices[0].Treatment != "checkup" {
+				t.Errorf("expected only the price of %q to remain but got %v", "checkup", prices)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"slices"
+
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
+)
+
+func (r *Repository) DetectTreatments(ctx context.Context, req *treatmentv1alpha.DetectTreatmentsRequest, opts textmatch.Options) (*treatmentv1alpha.DetectTreatmentsResponse, error) {
+	ctx, end := observe(ctx, "DetectTreatments")
+	defer end()
+
+	if req.Fuzzy {
+		opts.Fuzzy = true
+	}
+
+	species := req.Species
+	if len(species) == 0 && req.DetectSpecies {
+		detected, err := r.DetectSpecies(ctx, &treatmentv1.DetectSpeciesRequest{Values: req.Values}, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// without a detected species there is nothing to constrain the
+		// query to. Return no treatments rather than matching the
+		// whole catalog.
+		if len(detected) == 0 {
+			return &treatmentv1alpha.DetectTreatmentsResponse{}, nil
+		}
+
+		for _, s := range detected {
+			species = append(species, s.Name)
+		}
+	}
+
+	treatments, err := r.QuerySpecies(ctx, species, "")
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make([]textmatch.Text, len(req.Values))
+	for idx, v := range req.Values {
+		texts[idx] = textmatch.NewText(v)
+	}
+
+	var (
+		result []*treatmentv1alpha.TreatmentMatch
+		scores = make(map[string]textmatch.Score)
+	)
+
+	for _, t := range treatments {
+		var (
+			score        textmatch.Score
+			explanations []*treatmentv1alpha.MatchExplanation
+		)
+
+		check := func(word string, source treatmentv1alpha.MatchSource) {
+			for idx, text := range texts {
+				m, ok := text.Match(word, opts)
+				if !ok {
+					continue
+				}
+
+				score.Add(m)
+				explanations = append(explanations, &treatmentv1alpha.MatchExplanation{
+					Value:     req.Values[idx],
+					MatchWord: word,
+					Source:    source,
+					Exact:     m.Exact,
+					Distance:  int32(m.Distance),
+				})
+			}
+		}
+
+		for _, m := range t.MatchEventText {
+			check(m, treatmentv1alpha.MatchSource_MATCH_SOURCE_MATCH_EVENT_TEXT)
+		}
+		check(t.DisplayName, treatmentv1alpha.MatchSource_MATCH_SOURCE_DISPLAY_NAME)
+
+		if len(explanations) == 0 {
+			continue
+		}
+
+		scores[t.Name] = score
+		result = append(result, &treatmentv1alpha.TreatmentMatch{
+			Treatment:    t,
+			ExactMatches: int32(score.Exact),
+			FuzzyMatches: int32(score.Fuzzy),
+			Explanations: explanations,
+		})
+	}
+
+	slices.SortStableFunc(result, func(a, b *treatmentv1alpha.TreatmentMatch) int {
+		return scores[b.Treatment.Name].Compare(scores[a.Treatment.Name])
+	})
+
+	return &treatmentv1alpha.DetectTreatmentsResponse{
+		Matches: result,
+		Species: species,
+	}, nil
+}
@@ -0,0 +1,227 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (r *Repository) CreateDetectionFixture(ctx context.Context, f *treatmentv1alpha.DetectionFixture) (*treatmentv1alpha.DetectionFixture, error) {
+	ctx, end := observe(ctx, "CreateDetectionFixture")
+	defer end()
+
+	model := DetectionFixtureFromProto(f)
+
+	if _, err := r.fixtures.InsertOne(ctx, model); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("detection fixture with name %q already exists", f.Name))
+		}
+
+		return nil, fmt.Errorf("failed to persist detection fixture: %w", err)
+	}
+
+	return model.ToProto(), nil
+}
+
+func (r *Repository) ListDetectionFixtures(ctx context.Context, names []string) ([]*treatmentv1alpha.DetectionFixture, error) {
+	ctx, end := observe(ctx, "ListDetectionFixtures")
+	defer end()
+
+	filter := bson.M{}
+
+	if len(names) > 0 {
+		filter["name"] = bson.M{
+			"$in": names,
+		}
+	}
+
+	res, err := r.fixtures.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform find operation: %w", err)
+	}
+
+	var m []DetectionFixture
+	if err := res.All(ctx, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more detection fixture database models: %w", err)
+	}
+
+	result := make([]*treatmentv1alpha.DetectionFixture, len(m))
+	for idx, f := range m {
+		result[idx] = f.ToProto()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) DeleteDetectionFixture(ctx context.Context, name string) error {
+	ctx, end := observe(ctx, "DeleteDetectionFixture")
+	defer end()
+
+	res, err := r.fixtures.DeleteOne(ctx, bson.M{"name": name})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("detection fixture with name %q not found", name))
+	}
+
+	return nil
+}
+
+// EvaluateDetectionFixtures evaluates all or the named detection fixtures
+// using the same code path as DetectSpecies and DetectTreatments.
+func (r *Repository) EvaluateDetectionFixtures(ctx context.Context, names []string, opts textmatch.Options) ([]*treatmentv1alpha.DetectionFixtureResult, error) {
+	ctx, end := observe(ctx, "EvaluateDetectionFixtures")
+	defer end()
+
+	fixtures, err := r.ListDetectionFixtures(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*treatmentv1alpha.DetectionFixtureResult, len(fixtures))
+	for idx, f := range fixtures {
+		results[idx], err = r.evaluateFixture(ctx, f, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate fixture %q: %w", f.Name, err)
+		}
+	}
+
+	return results, nil
+}
+
+// GuardDetectionFixtures executes fn in a transaction and evaluates all detection
+// fixtures before and after. Any fixture that passed before but fails afterwards
+// is returned as a regression. If reject is set to true, the transaction
+// is aborted if there are regressions.
+func (r *Repository) GuardDetectionFixtures(ctx context.Context, opts textmatch.Options, reject bool, fn func(ctx context.Context) (any, error)) (any, []*treatmentv1alpha.DetectionFixtureResult, error) {
+	ctx, end := observe(ctx, "GuardDetectionFixtures")
+	defer end()
+
+	var regressions []*treatmentv1alpha.DetectionFixtureResult
+
+	result, err := r.withTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+		// the transaction might be retried so make sure to start over
+		regressions = nil
+
+		before, err := r.EvaluateDetectionFixtures(sc, nil, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := fn(sc)
+		if err != nil {
+			return nil, err
+		}
+
+		after, err := r.EvaluateDetectionFixtures(sc, nil, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		passed := make(map[string]bool, len(before))
+		for _, b := range before {
+			passed[b.Name] = b.Passed
+		}
+
+		for _, a := range after {
+			if passed[a.Name] && !a.Passed {
+				regressions = append(regressions, a)
+			}
+		}
+
+		if reject && len(regressions) > 0 {
+			return nil, newFixtureRegressionError(regressions)
+		}
+
+		return res, nil
+	})
+	if err != nil {
+		return nil, regressions, err
+	}
+
+	return result, regressions, nil
+}
+
+func newFixtureRegressionError(regressions []*treatmentv1alpha.DetectionFixtureResult) error {
+	names := make([]string, len(regressions))
+	for idx, r := range regressions {
+		names[idx] = r.Name
+	}
+
+	cerr := connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("change causes previously passing detection fixtures to fail: %s", strings.Join(names, ", ")))
+
+	if detail, err := connect.NewErrorDetail(&treatmentv1alpha.DetectionFixtureRegressions{
+		Regressions: regressions,
+	}); err == nil {
+		cerr.AddDetail(detail)
+	}
+
+	return cerr
+}
+
+func (r *Repository) evaluateFixture(ctx context.Context, f *treatmentv1alpha.DetectionFixture, opts textmatch.Options) (*treatmentv1alpha.DetectionFixtureResult, error) {
+	result := &treatmentv1alpha.DetectionFixtureResult{
+		Name: f.Name,
+	}
+
+	species, err := r.DetectSpecies(ctx, &treatmentv1.DetectSpeciesRequest{Values: f.Values}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range species {
+		result.DetectedSpecies = append(result.DetectedSpecies, s.Name)
+	}
+
+	if len(f.ExpectedSpecies) > 0 {
+		result.Failures = append(result.Failures, checkExpectations("species", f.ExpectedSpecies, result.DetectedSpecies)...)
+	}
+
+	if len(f.ExpectedTreatments) > 0 {
+		treatments, err := r.DetectTreatments(ctx, &treatmentv1alpha.DetectTreatmentsRequest{
+			Values:        f.Values,
+			DetectSpecies: true,
+		}, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range treatments.Matches {
+			result.DetectedTreatments = append(result.DetectedTreatments, m.Treatment.Name)
+		}
+
+		result.Failures = append(result.Failures, checkExpectations("treatment", f.ExpectedTreatments, result.DetectedTreatments)...)
+	}
+
+	result.Passed = len(result.Failures) == 0
+
+	return result, nil
+}
+
+// checkExpectations ensures all expected values have been detected and the best
+// match (the first detected value) is one of the expected.
+func checkExpectations(kind string, expected, detected []string) []string {
+	var failures []string
+
+	for _, e := range expected {
+		if !slices.Contains(detected, e) {
+			failures = append(failures, fmt.Sprintf("expected %s %q was not detected", kind, e))
+		}
+	}
+
+	if len(detected) > 0 && !slices.Contains(expected, detected[0]) {
+		failures = append(failures, fmt.Sprintf("best matching %s is %q, expected one of %s", kind, detected[0], strings.Join(expected, ", ")))
+	}
+
+	return failures
+}
@@ -0,0 +1,208 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *Repository) SetSpeciesParent(ctx context.Context, name, parent string) (*treatmentv1alpha.SpeciesNode, error) {
+	ctx, end := observe(ctx, "SetSpeciesParent")
+	defer end()
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		tree, err := r.loadSpeciesTree(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := tree.parents[name]; !ok {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("species %q not found", name))
+		}
+
+		if parent != "" {
+			if _, ok := tree.parents[parent]; !ok {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parent species %q not found", parent))
+			}
+
+			if parent == name || slices.Contains(tree.Ancestors(parent), name) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("species %q cannot be moved into %q as this would create a cycle", name, parent))
+			}
+		}
+
+		if _, err := r.species.UpdateOne(ctx, bson.M{"name": name}, bson.M{
+			"$set": bson.M{
+				"parent": parent,
+			},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update species parent: %w", err)
+		}
+
+		tree.parents[name] = parent
+
+		return tree.Node(name), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.SpeciesNode), nil
+}
+
+func (r *Repository) GetSpeciesHierarchy(ctx context.Context) ([]*treatmentv1alpha.SpeciesNode, error) {
+	ctx, end := observe(ctx, "GetSpeciesHierarchy")
+	defer end()
+
+	tree, err := r.loadSpeciesTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*treatmentv1alpha.SpeciesNode, len(tree.names))
+	for idx, name := range tree.names {
+		result[idx] = tree.Node(name)
+	}
+
+	return result, nil
+}
+
+// speciesTree holds the parent relation of all species.
+type speciesTree struct {
+	// names holds all species names in database order.
+	names []string
+
+	// parents maps a species name to the name of the parent species.
+	parents map[string]string
+}
+
+func (r *Repository) loadSpeciesTree(ctx context.Context) (*speciesTree, error) {
+	res, err := r.species.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{"name": 1, "parent": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform find operation: %w", err)
+	}
+
+	var docs []struct {
+		Name   string `bson:"name"`
+		Parent string `bson:"parent"`
+	}
+	if err := res.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more species database models: %w", err)
+	}
+
+	tree := &speciesTree{
+		names:   make([]string, len(docs)),
+		parents: make(map[string]string, len(docs)),
+	}
+
+	for idx, d := range docs {
+		tree.names[idx] = d.Name
+		tree.parents[d.Name] = d.Parent
+	}
+
+	return tree, nil
+}
+
+// Ancestors returns all ancestors of name, the direct parent first.
+func (t *speciesTree) Ancestors(name string) []string {
+	var result []string
+
+	for p := t.parents[name]; p != "" && p != name && !slices.Contains(result, p); p = t.parents[p] {
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// Children returns the direct children of name.
+func (t *speciesTree) Children(name string) []string {
+	var result []string
+
+	for _, n := range t.names {
+		if t.parents[n] == name {
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+// Includes reports whether species or any of its ancestors is listed in names.
+func (t *speciesTree) Includes(names []string, species string) bool {
+	if slices.Contains(names, species) {
+		return true
+	}
+
+	for _, a := range t.Ancestors(species) {
+		if slices.Contains(names, a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithAncestors returns names and the ancestors of each species in names.
+func (t *speciesTree) WithAncestors(names []string) []string {
+	result := slices.Clone(names)
+
+	for _, n := range names {
+		for _, a := range t.Ancestors(n) {
+			if !slices.Contains(result, a) {
+				result = append(result, a)
+			}
+		}
+	}
+
+	return result
+}
+
+// Expand returns names and all descendants of each species in names.
+func (t *speciesTree) Expand(names []string) []string {
+	if len(names) == 0 {
+		return names
+	}
+
+	var result []string
+	for _, n := range t.names {
+		if t.Includes(names, n) {
+			result = append(result, n)
+		}
+	}
+
+	// keep unknown names so callers can still report them
+	for _, n := range names {
+		if !slices.Contains(result, n) {
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+// topmost returns all species of names that do not have an ancestor in names.
+func (t *speciesTree) topmost(names []string) []string {
+	var result []string
+
+	for _, n := range names {
+		if !slices.ContainsFunc(t.Ancestors(n), func(a string) bool { return slices.Contains(names, a) }) {
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+func (t *speciesTree) Node(name string) *treatmentv1alpha.SpeciesNode {
+	return &treatmentv1alpha.SpeciesNode{
+		Name:      name,
+		Parent:    t.parents[name],
+		Children:  t.Children(name),
+		Ancestors: t.Ancestors(name),
+	}
+}
@@ -0,0 +1,284 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// collection wraps a catalog collection. On deployments without transaction
+// support, write operations that run as part of withTransaction record the
+// pre-images of the documents they touch in the journal of the operation so
+// a failed operation can be rolled back. Outside of such an operation, all
+// methods behave like the ones of mongo.Collection.
+//
+// BulkWrite is not journaled and must not be used for catalog collections.
+type collection struct {
+	*mongo.Collection
+}
+
+type journalContextKey struct{}
+
+// journal records the state of all documents touched by a write operation
+// before they have been modified for the first time.
+type journal struct {
+	l       sync.Mutex
+	seen    map[string]struct{}
+	entries []journalEntry
+}
+
+// journalEntry holds the pre-image of a single document.
+type journalEntry struct {
+	collection *mongo.Collection
+	id         bson.RawValue
+
+	// doc is nil if the document has been created by the operation.
+	doc bson.Raw
+}
+
+func newJournal() *journal {
+	return &journal{
+		seen: make(map[string]struct{}),
+	}
+}
+
+func withJournal(ctx context.Context, j *journal) context.Context {
+	return context.WithValue(ctx, journalContextKey{}, j)
+}
+
+func journalFromContext(ctx context.Context) *journal {
+	j, _ := ctx.Value(journalContextKey{}).(*journal)
+
+	return j
+}
+
+// add records doc as the pre-image of the document identified by id unless
+// the document has already been recorded.
+func (j *journal) add(col *mongo.Collection, id bson.RawValue, doc bson.Raw) {
+	j.l.Lock()
+	defer j.l.Unlock()
+
+	key := col.Name() + "/" + string(append([]byte{byte(id.Type)}, id.Value...))
+	if _, ok := j.seen[key]; ok {
+		return
+	}
+
+	j.seen[key] = struct{}{}
+	j.entries = append(j.entries, journalEntry{
+		collection: col,
+		id:         id,
+		doc:        doc,
+	})
+}
+
+// created records that the document identified by id has been created.
+func (j *journal) created(col *mongo.Collection, id any) error {
+	t, value, err := bson.MarshalValue(id)
+	if err != nil {
+		return fmt.Errorf("failed to record created document in %s: %w", col.Name(), err)
+	}
+
+	j.add(col, bson.RawValue{Type: t, Value: value}, nil)
+
+	return nil
+}
+
+// rollback restores all recorded pre-images and deletes created documents.
+// Entries are undone in reverse order so restored documents do not violate
+// unique indexes.
+func (j *journal) rollback(ctx context.Context) error {
+	j.l.Lock()
+	defer j.l.Unlock()
+
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		e := j.entries[i]
+
+		if e.doc == nil {
+			if _, err := e.collection.DeleteOne(ctx, bson.M{"_id": e.id}); err != nil {
+				return fmt.Errorf("failed to delete created document from %s: %w", e.collection.Name(), err)
+			}
+
+			continue
+		}
+
+		if _, err := e.collection.ReplaceOne(ctx, bson.M{"_id": e.id}, e.doc, options.Replace().SetUpsert(true)); err != nil {
+			return fmt.Errorf("failed to restore document in %s: %w", e.collection.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// capture records the pre-images of all documents matching filter if ctx
+// carries a journal.
+func (c *collection) capture(ctx context.Context, filter any) error {
+	j := journalFromContext(ctx)
+	if j == nil {
+		return nil
+	}
+
+	cursor, err := c.Collection.Find(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to record documents of %s: %w", c.Name(), err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		// the current document is only valid until the next call to Next
+		doc := slices.Clone(cursor.Current)
+
+		j.add(c.Collection, doc.Lookup("_id"), doc)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("failed to record documents of %s: %w", c.Name(), err)
+	}
+
+	return nil
+}
+
+// recordUpsert records the document created by an upsert, if any.
+func (c *collection) recordUpsert(ctx context.Context, res *mongo.UpdateResult) error {
+	j := journalFromContext(ctx)
+	if j == nil || res == nil || res.UpsertedID == nil {
+		return nil
+	}
+
+	return j.created(c.Collection, res.UpsertedID)
+}
+
+func (c *collection) InsertOne(ctx context.Context, document any, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	res, err := c.Collection.InsertOne(ctx, document, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if j := journalFromContext(ctx); j != nil {
+		if err := j.created(c.Collection, res.InsertedID); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (c *collection) InsertMany(ctx context.Context, documents []any, opts ...*options.InsertManyOptions) (*mongo.InsertManyResult, error) {
+	res, err := c.Collection.InsertMany(ctx, documents, opts...)
+
+	// with unordered inserts, some documents might have been created even
+	// though an error is returned.
+	if j := journalFromContext(ctx); j != nil && res != nil {
+		for _, id := range res.InsertedIDs {
+			if jerr := j.created(c.Collection, id); jerr != nil && err == nil {
+				err = jerr
+			}
+		}
+	}
+
+	return res, err
+}
+
+func (c *collection) UpdateOne(ctx context.Context, filter any, update any, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	if err := c.capture(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	res, err := c.Collection.UpdateOne(ctx, filter, update, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, c.recordUpsert(ctx, res)
+}
+
+func (c *collection) UpdateMany(ctx context.Context, filter any, update any, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	if err := c.capture(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	res, err := c.Collection.UpdateMany(ctx, filter, update, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, c.recordUpsert(ctx, res)
+}
+
+func (c *collection) ReplaceOne(ctx context.Context, filter any, replacement any, opts ...*options.ReplaceOptions) (*mongo.UpdateResult, error) {
+	if err := c.capture(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	res, err := c.Collection.ReplaceOne(ctx, filter, replacement, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, c.recordUpsert(ctx, res)
+}
+
+func (c *collection) DeleteOne(ctx context.Context, filter any, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	if err := c.capture(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	return c.Collection.DeleteOne(ctx, filter, opts...)
+}
+
+func (c *collection) DeleteMany(ctx context.Context, filter any, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	if err := c.capture(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	return c.Collection.DeleteMany(ctx, filter, opts...)
+}
+
+// FindOneAndUpdate records the pre-images of all documents matching filter.
+// Documents created by an upsert are only recorded if the updated document
+// is returned.
+func (c *collection) FindOneAndUpdate(ctx context.Context, filter any, update any, opts ...*options.FindOneAndUpdateOptions) *mongo.SingleResult {
+	if err := c.capture(ctx, filter); err != nil {
+		return mongo.NewSingleResultFromDocument(bson.D{}, err, nil)
+	}
+
+	res := c.Collection.FindOneAndUpdate(ctx, filter, update, opts...)
+
+	if j := journalFromContext(ctx); j != nil && res.Err() == nil {
+		// documents that have not been captured before the update have
+		// been created by it. add ignores all others.
+		if doc, err := res.Raw(); err == nil {
+			j.add(c.Collection, doc.Lookup("_id"), nil)
+		}
+	}
+
+	return res
+}
+
+func (c *collection) FindOneAndReplace(ctx context.Context, filter any, replacement any, opts ...*options.FindOneAndReplaceOptions) *mongo.SingleResult {
+	if err := c.capture(ctx, filter); err != nil {
+		return mongo.NewSingleResultFromDocument(bson.D{}, err, nil)
+	}
+
+	res := c.Collection.FindOneAndReplace(ctx, filter, replacement, opts...)
+
+	if j := journalFromContext(ctx); j != nil && res.Err() == nil {
+		if doc, err := res.Raw(); err == nil {
+			j.add(c.Collection, doc.Lookup("_id"), nil)
+		}
+	}
+
+	return res
+}
+
+func (c *collection) FindOneAndDelete(ctx context.Context, filter any, opts ...*options.FindOneAndDeleteOptions) *mongo.SingleResult {
+	if err := c.capture(ctx, filter); err != nil {
+		return mongo.NewSingleResultFromDocument(bson.D{}, err, nil)
+	}
+
+	return c.Collection.FindOneAndDelete(ctx, filter, opts...)
+}
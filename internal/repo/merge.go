@@ -0,0 +1,295 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MergeSpecies moves all references of the source species to the target
+// species and deletes the source species. If req.DryRun is set, only the
+// affected entities are reported.
+func (r *Repository) MergeSpecies(ctx context.Context, req *treatmentv1alpha.MergeSpeciesRequest) (*treatmentv1alpha.MergeSpeciesResponse, error) {
+	ctx, end := observe(ctx, "MergeSpecies")
+	defer end()
+
+	if req.Source == req.Target {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a species cannot be merged into itself"))
+	}
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		source, err := r.findSpecies(ctx, req.Source)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := r.findSpecies(ctx, req.Target)
+		if err != nil {
+			return nil, err
+		}
+
+		tree, err := r.loadSpeciesTree(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(tree.Ancestors(source.Name), target.Name) || slices.Contains(tree.Ancestors(target.Name), source.Name) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("species %q and %q are part of the same species group", source.Name, target.Name))
+		}
+
+		response := &treatmentv1alpha.MergeSpeciesResponse{
+			AffectedSpecies: tree.Children(source.Name),
+		}
+
+		response.AffectedTreatments, err = r.distinctNames(ctx, r.treatments, bson.M{
+			"$or": bson.A{
+				bson.M{"species": source.Name},
+				bson.M{"speciesPreparationInstructions.species": source.Name},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		response.AffectedBreeds, err = r.distinctNames(ctx, r.breeds, bson.M{"species": source.Name})
+		if err != nil {
+			return nil, err
+		}
+
+		response.AffectedFixtures, err = r.distinctNames(ctx, r.fixtures, bson.M{"expectedSpecies": source.Name})
+		if err != nil {
+			return nil, err
+		}
+
+		response.PriceConflicts, err = r.priceConflicts(ctx, source.Name, target.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		merged := mergeSpeciesModels(source, target, req.Icon)
+		response.Species = merged.ToProto()
+
+		if req.DryRun {
+			return response, nil
+		}
+
+		if len(response.PriceConflicts) > 0 {
+			conflicts := make([]string, len(response.PriceConflicts))
+			for i, c := range response.PriceConflicts {
+				conflicts[i] = fmt.Sprintf("%s valid from %s", c.Treatment, c.ValidFrom.AsTime().Format(time.RFC3339))
+			}
+
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("species %q and %q have conflicting prices: %s", source.Name, target.Name, strings.Join(conflicts, ", ")))
+		}
+
+		if err := r.replaceSpeciesReferences(ctx, source.Name, target.Name); err != nil {
+			return nil, err
+		}
+
+		if _, err := r.species.UpdateOne(ctx, bson.M{"name": target.Name}, bson.M{
+			"$set": bson.M{
+				"matchWords": merged.MatchWords,
+				"iconData":   merged.Icon,
+				"iconType":   merged.IconType,
+			},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update target species: %w", err)
+		}
+
+		if _, err := r.species.DeleteOne(ctx, bson.M{"name": source.Name}); err != nil {
+			return nil, fmt.Errorf("failed to delete source species: %w", err)
+		}
+
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.MergeSpeciesResponse), nil
+}
+
+// replaceSpeciesReferences replaces all references to the species from with
+// the species to.
+func (r *Repository) replaceSpeciesReferences(ctx context.Context, from, to string) error {
+	if err := replaceInArray(ctx, r.treatments, "species", from, to); err != nil {
+		return fmt.Errorf("failed to update treatment species: %w", err)
+	}
+
+	// species specific preparation instructions of the target species
+	// take precedence.
+	if _, err := r.treatments.UpdateMany(
+		ctx,
+		bson.M{
+			"$and": bson.A{
+				bson.M{"speciesPreparationInstructions.species": from},
+				bson.M{"speciesPreparationInstructions.species": to},
+			},
+		},
+		bson.M{
+			"$pull": bson.M{
+				"speciesPreparationInstructions": bson.M{"species": from},
+			},
+		},
+	); err != nil {
+		return fmt.Errorf("failed to update preparation instructions: %w", err)
+	}
+
+	if _, err := r.treatments.UpdateMany(
+		ctx,
+		bson.M{"speciesPreparationInstructions.species": from},
+		bson.M{
+			"$set": bson.M{
+				"speciesPreparationInstructions.$[e].species": to,
+			},
+		},
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []any{bson.M{"e.species": from}},
+		}),
+	); err != nil {
+		return fmt.Errorf("failed to update preparation instructions: %w", err)
+	}
+
+	if _, err := r.breeds.UpdateMany(ctx, bson.M{"species": from}, bson.M{"$set": bson.M{"species": to}}); err != nil {
+		return fmt.Errorf("failed to update breeds: %w", err)
+	}
+
+	if _, err := r.prices.UpdateMany(ctx, bson.M{"species": from}, bson.M{"$set": bson.M{"species": to}}); err != nil {
+		return fmt.Errorf("failed to update prices: %w", err)
+	}
+
+	if _, err := r.species.UpdateMany(ctx, bson.M{"parent": from}, bson.M{"$set": bson.M{"parent": to}}); err != nil {
+		return fmt.Errorf("failed to update child species: %w", err)
+	}
+
+	if err := replaceInArray(ctx, r.fixtures, "expectedSpecies", from, to); err != nil {
+		return fmt.Errorf("failed to update detection fixtures: %w", err)
+	}
+
+	return nil
+}
+
+// replaceInArray replaces from with to in the array field of all documents
+// in col without creating duplicates.
+func replaceInArray(ctx context.Context, col *collection, field, from, to string) error {
+	if _, err := col.UpdateMany(ctx, bson.M{field: from}, bson.M{
+		"$addToSet": bson.M{
+			field: to,
+		},
+	}); err != nil {
+		return err
+	}
+
+	if _, err := col.UpdateMany(ctx, bson.M{field: from}, bson.M{
+		"$pull": bson.M{
+			field: from,
+		},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// priceConflicts returns all prices of the species from that collide with a
+// price of the species to for the same treatment and valid-from time.
+func (r *Repository) priceConflicts(ctx context.Context, from, to string) ([]*treatmentv1alpha.PriceConflict, error) {
+	prices, err := r.findPrices(ctx, bson.M{"species": bson.M{"$in": bson.A{from, to}}})
+	if err != nil {
+		return nil, err
+	}
+
+	type priceKey struct {
+		treatment string
+		validFrom time.Time
+	}
+
+	existing := make(map[priceKey]Price)
+	for _, p := range prices {
+		if p.Species == to {
+			existing[priceKey{p.Treatment, p.ValidFrom}] = p
+		}
+	}
+
+	var conflicts []*treatmentv1alpha.PriceConflict
+	for _, p := range prices {
+		if p.Species != from {
+			continue
+		}
+
+		if t, ok := existing[priceKey{p.Treatment, p.ValidFrom}]; ok {
+			conflicts = append(conflicts, &treatmentv1alpha.PriceConflict{
+				Treatment:   p.Treatment,
+				ValidFrom:   timestamppb.New(p.ValidFrom),
+				SourcePrice: p.ID.Hex(),
+				TargetPrice: t.ID.Hex(),
+			})
+		}
+	}
+
+	return conflicts, nil
+}
+
+// distinctNames returns the names of all documents in col that match filter.
+func (r *Repository) distinctNames(ctx context.Context, col *collection, filter bson.M) ([]string, error) {
+	values, err := col.Distinct(ctx, "name", filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find affected %s: %w", col.Name(), err)
+	}
+
+	names := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			names = append(names, s)
+		}
+	}
+
+	return names, nil
+}
+
+// mergeSpeciesModels returns target with the match words of source added and
+// the icon selected according to icon.
+func mergeSpeciesModels(source, target Species, icon treatmentv1alpha.MergeIcon) Species {
+	merged := target
+	merged.MatchWords = slices.Clone(target.MatchWords)
+
+	seen := make(map[string]struct{}, len(target.MatchWords)+len(source.MatchWords))
+	for _, m := range target.MatchWords {
+		seen[textmatch.Normalize(m)] = struct{}{}
+	}
+
+	for _, m := range source.MatchWords {
+		key := textmatch.Normalize(m)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		merged.MatchWords = append(merged.MatchWords, m)
+	}
+
+	useSource := false
+	switch icon {
+	case treatmentv1alpha.MergeIcon_MERGE_ICON_SOURCE:
+		useSource = true
+	case treatmentv1alpha.MergeIcon_MERGE_ICON_UNSPECIFIED:
+		useSource = target.IconType == 0
+	}
+
+	if useSource {
+		merged.Icon = source.Icon
+		merged.IconType = source.IconType
+	}
+
+	return merged
+}
@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// migration is a one-time data migration. Applied migrations are recorded in
+// the migrations collection by their ID.
+type migration struct {
+	ID          string
+	Description string
+	Apply       func(ctx context.Context, r *Repository) error
+}
+
+// migrations holds all data migrations in the order they are applied. New
+// migrations must be appended and existing IDs must never be changed.
+var migrations = []migration{
+	{
+		ID:          "species-icon-data",
+		Description: "move species icons stored by UpdateSpecies from icon to iconData",
+		Apply: func(ctx context.Context, r *Repository) error {
+			_, err := r.species.UpdateMany(ctx, bson.M{"icon": bson.M{"$exists": true}}, bson.M{
+				"$rename": bson.M{
+					"icon": "iconData",
+				},
+			})
+
+			return err
+		},
+	},
+}
+
+type appliedMigration struct {
+	ID        string    `bson:"_id"`
+	AppliedAt time.Time `bson:"appliedAt"`
+}
+
+// migrate applies all pending migrations.
+func (r *Repository) migrate(ctx context.Context) error {
+	pending, err := r.PendingMigrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if !slices.Contains(pending, m.ID) {
+			continue
+		}
+
+		slog.InfoContext(ctx, "applying database migration", "id", m.ID, "description", m.Description)
+
+		if err := m.Apply(ctx, r); err != nil {
+			return fmt.Errorf("failed to apply migration %q: %w", m.ID, err)
+		}
+
+		if _, err := r.migrations.InsertOne(ctx, appliedMigration{ID: m.ID, AppliedAt: time.Now()}); err != nil && !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("failed to record migration %q: %w", m.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// PendingMigrations returns the IDs of all migrations that have not been
+// applied yet.
+func (r *Repository) PendingMigrations(ctx context.Context) ([]string, error) {
+	res, err := r.migrations.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform find operation: %w", err)
+	}
+
+	var applied []appliedMigration
+	if err := res.All(ctx, &applied); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more migration database models: %w", err)
+	}
+
+	var pending []string
+	for _, m := range migrations {
+		if !slices.ContainsFunc(applied, func(a appliedMigration) bool { return a.ID == m.ID }) {
+			pending = append(pending, m.ID)
+		}
+	}
+
+	return pending, nil
+}
@@ -1,10 +1,16 @@
 package repo
 
 import (
+	"math"
+	"slices"
 	"time"
 
+	commonv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/common/v1"
 	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Species struct {
@@ -14,6 +20,7 @@ type Species struct {
 	MatchWords              []string `bson:"matchWords"`
 	Icon                    []byte   `bson:"iconData"`
 	IconType                uint8    `bson:"iconType"`
+	Parent                  string   `bson:"parent,omitempty"`
 }
 
 func (s Species) ToProto() *treatmentv1.Species {
@@ -51,17 +58,181 @@ func SpeciesFromProto(spb *treatmentv1.Species) Species {
 }
 
 type Treatment struct {
-	Name                      string        `bson:"name"`
-	DisplayName               string        `bson:"displayName"`
-	HelpText                  string        `bson:"helpText"`
-	Species                   []string      `bson:"species"`
-	InitialTimeRequirement    time.Duration `bson:"initialTimeRequirement"`
-	AdditionalTimeRequirement time.Duration `bson:"additionalTimeRequirement"`
-	AllowedEmployees          []string      `bson:"allowedEmployees"`
-	PreferredEmployees        []string      `bson:"preferredEmployees"`
-	MatchEventText            []string      `bson:"matchEventText"`
-	AllowSelfBooking          bool          `bson:"allowSelfBooking"`
-	Resources                 []string      `bson:"resources"`
+	Name                      string         `bson:"name"`
+	DisplayName               string         `bson:"displayName"`
+	HelpText                  string         `bson:"helpText"`
+	Species                   []string       `bson:"species"`
+	InitialTimeRequirement    time.Duration  `bson:"initialTimeRequirement"`
+	AdditionalTimeRequirement time.Duration  `bson:"additionalTimeRequirement"`
+	AllowedEmployees          []string       `bson:"allowedEmployees"`
+	PreferredEmployees        []string       `bson:"preferredEmployees"`
+	MatchEventText            []string       `bson:"matchEventText"`
+	AllowSelfBooking          bool           `bson:"allowSelfBooking"`
+	Resources                 []string       `bson:"resources"`
+	Prerequisites             []Prerequisite `bson:"prerequisites,omitempty"`
+
+	PreparationInstructions        []string                         `bson:"preparationInstructions,omitempty"`
+	SpeciesPreparationInstructions []SpeciesPreparationInstructions `bson:"speciesPreparationInstructions,omitempty"`
+
+	PreOpRequirements *PreOpRequirements `bson:"preOpRequirements,omitempty"`
+
+	Eligibility *Eligibility `bson:"eligibility,omitempty"`
+
+	Template bool `bson:"template,omitempty"`
+}
+
+type Eligibility struct {
+	Sexes              []int32 `bson:"sexes"`
+	CastrationStatuses []int32 `bson:"castrationStatuses"`
+}
+
+func (e Eligibility) ToProto() *treatmentv1alpha.Eligibility {
+	epb := &treatmentv1alpha.Eligibility{}
+
+	for _, s := range e.Sexes {
+		epb.Sexes = append(epb.Sexes, treatmentv1alpha.Sex(s))
+	}
+
+	for _, c := range e.CastrationStatuses {
+		epb.CastrationStatuses = append(epb.CastrationStatuses, treatmentv1alpha.CastrationStatus(c))
+	}
+
+	return epb
+}
+
+func EligibilityFromProto(e *treatmentv1alpha.Eligibility) Eligibility {
+	var result Eligibility
+
+	for _, s := range e.Sexes {
+		result.Sexes = append(result.Sexes, int32(s))
+	}
+
+	for _, c := range e.CastrationStatuses {
+		result.CastrationStatuses = append(result.CastrationStatuses, int32(c))
+	}
+
+	return result
+}
+
+// IsEligible reports whether a patient with the given sex and castration
+// status is eligible for t. Unspecified attributes never render a patient
+// ineligible.
+func (t Treatment) IsEligible(sex treatmentv1alpha.Sex, castration treatmentv1alpha.CastrationStatus) bool {
+	if t.Eligibility == nil {
+		return true
+	}
+
+	if sex != treatmentv1alpha.Sex_SEX_UNSPECIFIED && len(t.Eligibility.Sexes) > 0 && !slices.Contains(t.Eligibility.Sexes, int32(sex)) {
+		return false
+	}
+
+	if castration != treatmentv1alpha.CastrationStatus_CASTRATION_STATUS_UNSPECIFIED && len(t.Eligibility.CastrationStatuses) > 0 && !slices.Contains(t.Eligibility.CastrationStatuses, int32(castration)) {
+		return false
+	}
+
+	return true
+}
+
+type PreOpRequirements struct {
+	FastingDuration time.Duration `bson:"fastingDuration"`
+	Anesthesia      int32         `bson:"anesthesia"`
+	// LatestStart is stored as the offset from midnight.
+	LatestStart  *time.Duration `bson:"latestStart,omitempty"`
+	RecoveryTime time.Duration  `bson:"recoveryTime"`
+}
+
+func (p PreOpRequirements) ToProto() *treatmentv1alpha.PreOpRequirements {
+	ppb := &treatmentv1alpha.PreOpRequirements{
+		Anesthesia: treatmentv1alpha.AnesthesiaType(p.Anesthesia),
+	}
+
+	if p.FastingDuration > 0 {
+		ppb.FastingDuration = durationpb.New(p.FastingDuration)
+	}
+
+	if p.LatestStart != nil {
+		ppb.LatestStart = commonv1.DayTimeFromDuration(*p.LatestStart)
+	}
+
+	if p.RecoveryTime > 0 {
+		ppb.RecoveryTime = durationpb.New(p.RecoveryTime)
+	}
+
+	return ppb
+}
+
+func PreOpRequirementsFromProto(p *treatmentv1alpha.PreOpRequirements) PreOpRequirements {
+	req := PreOpRequirements{
+		FastingDuration: p.FastingDuration.AsDuration(),
+		Anesthesia:      int32(p.Anesthesia),
+		RecoveryTime:    p.RecoveryTime.AsDuration(),
+	}
+
+	if p.LatestStart != nil {
+		d := p.LatestStart.AsDuration()
+		req.LatestStart = &d
+	}
+
+	return req
+}
+
+// RequiresAnesthesia reports whether t requires any kind of anesthesia.
+func (t Treatment) RequiresAnesthesia() bool {
+	return t.PreOpRequirements != nil && t.PreOpRequirements.Anesthesia != int32(treatmentv1alpha.AnesthesiaType_ANESTHESIA_TYPE_UNSPECIFIED)
+}
+
+// MayStartAt reports whether t may be started at the given offset
+// from midnight.
+func (t Treatment) MayStartAt(offset time.Duration) bool {
+	if t.PreOpRequirements == nil || t.PreOpRequirements.LatestStart == nil {
+		return true
+	}
+
+	return offset <= *t.PreOpRequirements.LatestStart
+}
+
+type SpeciesPreparationInstructions struct {
+	Species      string   `bson:"species"`
+	Instructions []string `bson:"instructions"`
+}
+
+// InstructionsFor returns the preparation instructions of t for the
+// given species.
+func (t Treatment) InstructionsFor(species string) []string {
+	for _, s := range t.SpeciesPreparationInstructions {
+		if s.Species == species {
+			return s.Instructions
+		}
+	}
+
+	return t.PreparationInstructions
+}
+
+type Prerequisite struct {
+	Treatments  []string      `bson:"treatments,omitempty"`
+	MaxAge      time.Duration `bson:"maxAge"`
+	Description string        `bson:"description"`
+}
+
+func (p Prerequisite) ToProto() *treatmentv1alpha.Prerequisite {
+	ppb := &treatmentv1alpha.Prerequisite{
+		Treatments:  p.Treatments,
+		Description: p.Description,
+	}
+
+	if p.MaxAge > 0 {
+		ppb.MaxAge = durationpb.New(p.MaxAge)
+	}
+
+	return ppb
+}
+
+func PrerequisiteFromProto(p *treatmentv1alpha.Prerequisite) Prerequisite {
+	return Prerequisite{
+		Treatments:  p.Treatments,
+		MaxAge:      p.MaxAge.AsDuration(),
+		Description: p.Description,
+	}
 }
 
 func (t Treatment) ToProto() *treatmentv1.Treatment {
@@ -80,6 +251,35 @@ func (t Treatment) ToProto() *treatmentv1.Treatment {
 	}
 }
 
+func (t Treatment) DetailsToProto() *treatmentv1alpha.TreatmentDetails {
+	details := &treatmentv1alpha.TreatmentDetails{
+		Name:                    t.Name,
+		PreparationInstructions: t.PreparationInstructions,
+		Template:                t.Template,
+	}
+
+	for _, p := range t.Prerequisites {
+		details.Prerequisites = append(details.Prerequisites, p.ToProto())
+	}
+
+	if t.PreOpRequirements != nil {
+		details.PreOpRequirements = t.PreOpRequirements.ToProto()
+	}
+
+	if t.Eligibility != nil {
+		details.Eligibility = t.Eligibility.ToProto()
+	}
+
+	for _, s := range t.SpeciesPreparationInstructions {
+		details.SpeciesPreparationInstructions = append(details.SpeciesPreparationInstructions, &treatmentv1alpha.SpeciesPreparationInstructions{
+			Species:      s.Species,
+			Instructions: s.Instructions,
+		})
+	}
+
+	return details
+}
+
 func TreatmentFromProto(t *treatmentv1.Treatment) Treatment {
 	return Treatment{
 		Name:                      t.Name,
@@ -95,3 +295,213 @@ func TreatmentFromProto(t *treatmentv1.Treatment) Treatment {
 		Resources:                 t.Resources,
 	}
 }
+
+type DetectionFixture struct {
+	Name               string   `bson:"name"`
+	Description        string   `bson:"description"`
+	Values             []string `bson:"values"`
+	ExpectedSpecies    []string `bson:"expectedSpecies"`
+	ExpectedTreatments []string `bson:"expectedTreatments"`
+}
+
+func (f DetectionFixture) ToProto() *treatmentv1alpha.DetectionFixture {
+	return &treatmentv1alpha.DetectionFixture{
+		Name:               f.Name,
+		Description:        f.Description,
+		Values:             f.Values,
+		ExpectedSpecies:    f.ExpectedSpecies,
+		ExpectedTreatments: f.ExpectedTreatments,
+	}
+}
+
+func DetectionFixtureFromProto(f *treatmentv1alpha.DetectionFixture) DetectionFixture {
+	return DetectionFixture{
+		Name:               f.Name,
+		Description:        f.Description,
+		Values:             f.Values,
+		ExpectedSpecies:    f.ExpectedSpecies,
+		ExpectedTreatments: f.ExpectedTreatments,
+	}
+}
+
+type Bundle struct {
+	Name             string        `bson:"name"`
+	DisplayName      string        `bson:"displayName"`
+	HelpText         string        `bson:"helpText"`
+	Treatments       []string      `bson:"treatments"`
+	Duration         time.Duration `bson:"duration"`
+	AllowSelfBooking bool          `bson:"allowSelfBooking"`
+}
+
+func (b Bundle) ToProto() *treatmentv1alpha.Bundle {
+	bpb := &treatmentv1alpha.Bundle{
+		Name:             b.Name,
+		DisplayName:      b.DisplayName,
+		HelpText:         b.HelpText,
+		Treatments:       b.Treatments,
+		AllowSelfBooking: b.AllowSelfBooking,
+	}
+
+	if b.Duration > 0 {
+		bpb.Duration = durationpb.New(b.Duration)
+	}
+
+	return bpb
+}
+
+func BundleFromProto(b *treatmentv1alpha.Bundle) Bundle {
+	return Bundle{
+		Name:             b.Name,
+		DisplayName:      b.DisplayName,
+		HelpText:         b.HelpText,
+		Treatments:       b.Treatments,
+		Duration:         b.Duration.AsDuration(),
+		AllowSelfBooking: b.AllowSelfBooking,
+	}
+}
+
+type Price struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	Treatment       string             `bson:"treatment"`
+	Species         string             `bson:"species"`
+	ValidFrom       time.Time          `bson:"validFrom"`
+	MinNet          int64              `bson:"minNet"`
+	MaxNet          int64              `bson:"maxNet"`
+	VatRate         float64            `bson:"vatRate"`
+	FeeScheduleCode string             `bson:"feeScheduleCode"`
+	Currency        string             `bson:"currency"`
+	Published       bool               `bson:"published"`
+}
+
+func (p Price) ToProto() *treatmentv1alpha.Price {
+	maxNet := p.MaxNet
+	if maxNet == 0 {
+		maxNet = p.MinNet
+	}
+
+	return &treatmentv1alpha.Price{
+		Id:              p.ID.Hex(),
+		Treatment:       p.Treatment,
+		Species:         p.Species,
+		ValidFrom:       timestamppb.New(p.ValidFrom),
+		MinNet:          p.MinNet,
+		MaxNet:          maxNet,
+		VatRate:         p.VatRate,
+		MinGross:        grossAmount(p.MinNet, p.VatRate),
+		MaxGross:        grossAmount(maxNet, p.VatRate),
+		FeeScheduleCode: p.FeeScheduleCode,
+		Currency:        p.Currency,
+		Published:       p.Published,
+	}
+}
+
+func PriceFromProto(p *treatmentv1alpha.Price) Price {
+	return Price{
+		Treatment:       p.Treatment,
+		Species:         p.Species,
+		ValidFrom:       p.ValidFrom.AsTime(),
+		MinNet:          p.MinNet,
+		MaxNet:          p.MaxNet,
+		VatRate:         p.VatRate,
+		FeeScheduleCode: p.FeeScheduleCode,
+		Currency:        p.Currency,
+		Published:       p.Published,
+	}
+}
+
+// grossAmount returns the gross amount in cents for the net amount and the
+// VAT rate in percent.
+func grossAmount(net int64, vatRate float64) int64 {
+	return int64(math.Round(float64(net) * (100 + vatRate) / 100))
+}
+
+type Breed struct {
+	Name        string   `bson:"name"`
+	Species     string   `bson:"species"`
+	DisplayName string   `bson:"displayName"`
+	MatchWords  []string `bson:"matchWords"`
+	Icon        []byte   `bson:"iconData"`
+	IconType    uint8    `bson:"iconType"`
+	Attributes  []string `bson:"attributes"`
+}
+
+func (b Breed) ToProto() *treatmentv1alpha.Breed {
+	bpb := &treatmentv1alpha.Breed{
+		Name:        b.Name,
+		Species:     b.Species,
+		DisplayName: b.DisplayName,
+		MatchWords:  b.MatchWords,
+		Attributes:  b.Attributes,
+	}
+
+	if b.IconType != 0 {
+		bpb.Icon = &treatmentv1.Icon{
+			Data: b.Icon,
+			Type: treatmentv1.IconType(b.IconType),
+		}
+	}
+
+	return bpb
+}
+
+func BreedFromProto(bpb *treatmentv1alpha.Breed) Breed {
+	b := Breed{
+		Name:        bpb.Name,
+		Species:     bpb.Species,
+		DisplayName: bpb.DisplayName,
+		MatchWords:  bpb.MatchWords,
+		Attributes:  bpb.Attributes,
+	}
+
+	if bpb.Icon != nil && bpb.Icon.Type != treatmentv1.IconType_ICON_TYPE_UNSPECIFIED {
+		b.Icon = bpb.Icon.Data
+		b.IconType = uint8(bpb.Icon.Type)
+	}
+
+	return b
+}
+
+type SpeciesDeletion struct {
+	Species            string    `bson:"species"`
+	Policy             int32     `bson:"policy"`
+	DeletedTreatments  []string  `bson:"deletedTreatments"`
+	ModifiedTreatments []string  `bson:"modifiedTreatments"`
+	DeletedBreeds      []string  `bson:"deletedBreeds"`
+	ModifiedBundles    []string  `bson:"modifiedBundles"`
+	DeletedBundles     []string  `bson:"deletedBundles"`
+	DeletedPrices      []string  `bson:"deletedPrices"`
+	DeletedBy          string    `bson:"deletedBy"`
+	DeletedAt          time.Time `bson:"deletedAt"`
+}
+
+func (d SpeciesDeletion) ToProto() *treatmentv1alpha.SpeciesDeletion {
+	return &treatmentv1alpha.SpeciesDeletion{
+		Impact: &treatmentv1alpha.DeleteSpeciesImpact{
+			Species:            d.Species,
+			Policy:             treatmentv1alpha.DeletePolicy(d.Policy),
+			DeletedTreatments:  d.DeletedTreatments,
+			ModifiedTreatments: d.ModifiedTreatments,
+			DeletedBreeds:      d.DeletedBreeds,
+			ModifiedBundles:    d.ModifiedBundles,
+			DeletedBundles:     d.DeletedBundles,
+			DeletedPrices:      d.DeletedPrices,
+		},
+		DeletedBy: d.DeletedBy,
+		DeletedAt: timestamppb.New(d.DeletedAt),
+	}
+}
+
+func SpeciesDeletionFromProto(impact *treatmentv1alpha.DeleteSpeciesImpact, deletedBy string, deletedAt time.Time) SpeciesDeletion {
+	return SpeciesDeletion{
+		Species:            impact.Species,
+		Policy:             int32(impact.Policy),
+		DeletedTreatments:  impact.DeletedTreatments,
+		ModifiedTreatments: impact.ModifiedTreatments,
+		DeletedBreeds:      impact.DeletedBreeds,
+		ModifiedBundles:    impact.ModifiedBundles,
+		DeletedBundles:     impact.DeletedBundles,
+		DeletedPrices:      impact.DeletedPrices,
+		DeletedBy:          deletedBy,
+		DeletedAt:          deletedAt,
+	}
+}
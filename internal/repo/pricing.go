@@ -0,0 +1,188 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (r *Repository) CreatePrice(ctx context.Context, p *treatmentv1alpha.Price) (*treatmentv1alpha.Price, error) {
+	ctx, end := observe(ctx, "CreatePrice")
+	defer end()
+
+	model := PriceFromProto(p)
+
+	if model.Currency == "" {
+		model.Currency = "EUR"
+	}
+
+	if model.MaxNet != 0 && model.MaxNet < model.MinNet {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_net must not be lower than min_net"))
+	}
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		if _, err := r.findTreatment(ctx, model.Treatment); err != nil {
+			return nil, err
+		}
+
+		if model.Species != "" {
+			if err := r.validateSpeciesExist(ctx, []string{model.Species}); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := r.prices.InsertOne(ctx, model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to persist price: %w", err)
+		}
+
+		model.ID = res.InsertedID.(primitive.ObjectID)
+
+		return model.ToProto(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.Price), nil
+}
+
+func (r *Repository) ListPrices(ctx context.Context, treatment, species string) ([]*treatmentv1alpha.Price, error) {
+	ctx, end := observe(ctx, "ListPrices")
+	defer end()
+
+	filter := bson.M{}
+
+	if treatment != "" {
+		filter["treatment"] = treatment
+	}
+
+	if species != "" {
+		filter["species"] = species
+	}
+
+	prices, err := r.findPrices(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*treatmentv1alpha.Price, len(prices))
+	for idx, p := range prices {
+		result[idx] = p.ToProto()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) DeletePrice(ctx context.Context, id string) error {
+	ctx, end := observe(ctx, "DeletePrice")
+	defer end()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid price id: %w", err))
+	}
+
+	res, err := r.prices.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("price with id %q not found", id))
+	}
+
+	return nil
+}
+
+// GetEffectivePrice returns the price of treatment that is valid at the given
+// time. Prices for the given species take precedence over prices without
+// species.
+func (r *Repository) GetEffectivePrice(ctx context.Context, treatment, species string, at time.Time) (*treatmentv1alpha.Price, error) {
+	ctx, end := observe(ctx, "GetEffectivePrice")
+	defer end()
+
+	prices, err := r.findPrices(ctx, bson.M{
+		"treatment": treatment,
+		"species": bson.M{
+			"$in": []string{species, ""},
+		},
+		"validFrom": bson.M{
+			"$lte": at,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := effectivePrice(prices, treatment, species, at)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no price found for treatment %q", treatment))
+	}
+
+	return p.ToProto(), nil
+}
+
+// findPrices returns all prices matching filter, the most recent
+// price first.
+func (r *Repository) findPrices(ctx context.Context, filter bson.M) ([]Price, error) {
+	res, err := r.prices.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "validFrom", Value: -1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform find operation: %w", err)
+	}
+
+	var prices []Price
+	if err := res.All(ctx, &prices); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more price database models: %w", err)
+	}
+
+	return prices, nil
+}
+
+// priceIDs returns the IDs of all prices matching filter.
+func (r *Repository) priceIDs(ctx context.Context, filter bson.M) ([]string, error) {
+	prices, err := r.findPrices(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(prices))
+	for i, p := range prices {
+		ids[i] = p.ID.Hex()
+	}
+
+	return ids, nil
+}
+
+// effectivePrice selects the price valid at the given time from prices which
+// must be sorted by validFrom in descending order.
+func effectivePrice(prices []Price, treatment, species string, at time.Time) (Price, bool) {
+	var (
+		generic    Price
+		hasGeneric bool
+	)
+
+	for _, p := range prices {
+		if p.Treatment != treatment || p.ValidFrom.After(at) {
+			continue
+		}
+
+		if species != "" && p.Species == species {
+			return p, true
+		}
+
+		if p.Species == "" && !hasGeneric {
+			generic = p
+			hasGeneric = true
+		}
+	}
+
+	return generic, hasGeneric
+}
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ReplaceReferences replaces or removes an employee or resource in all
+// treatments. If req.DryRun is set, the changes are only reported.
+func (r *Repository) ReplaceReferences(ctx context.Context, req *treatmentv1alpha.ReplaceReferencesRequest) (*treatmentv1alpha.ReplaceReferencesResponse, error) {
+	ctx, end := observe(ctx, "ReplaceReferences")
+	defer end()
+
+	var filter bson.M
+
+	switch req.Kind {
+	case treatmentv1alpha.ReferenceKind_REFERENCE_KIND_EMPLOYEE:
+		filter = bson.M{
+			"$or": bson.A{
+				bson.M{"allowedEmployees": req.From},
+				bson.M{"preferredEmployees": req.From},
+			},
+		}
+
+	case treatmentv1alpha.ReferenceKind_REFERENCE_KIND_RESOURCE:
+		filter = bson.M{"resources": req.From}
+
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid reference kind %s", req.Kind))
+	}
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		treatments, err := r.findTreatmentModels(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &treatmentv1alpha.ReplaceReferencesResponse{}
+		changed := make([]Treatment, 0, len(treatments))
+
+		for _, t := range treatments {
+			switch req.Kind {
+			case treatmentv1alpha.ReferenceKind_REFERENCE_KIND_EMPLOYEE:
+				hadAllowed := len(t.AllowedEmployees) > 0
+
+				t.AllowedEmployees = replaceReference(t.AllowedEmployees, req.From, req.To)
+				t.PreferredEmployees = replaceReference(t.PreferredEmployees, req.From, req.To)
+
+				if hadAllowed && len(t.AllowedEmployees) == 0 {
+					response.Conflicts = append(response.Conflicts, t.Name)
+				}
+
+				// keep preferred employees a subset of the allowed ones
+				if len(t.AllowedEmployees) > 0 {
+					t.PreferredEmployees = slices.DeleteFunc(t.PreferredEmployees, func(p string) bool {
+						return !slices.Contains(t.AllowedEmployees, p)
+					})
+				}
+
+			case treatmentv1alpha.ReferenceKind_REFERENCE_KIND_RESOURCE:
+				t.Resources = replaceReference(t.Resources, req.From, req.To)
+			}
+
+			changed = append(changed, t)
+			response.ChangedTreatments = append(response.ChangedTreatments, t.ToProto())
+		}
+
+		if req.DryRun {
+			return response, nil
+		}
+
+		if len(response.Conflicts) > 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("removing %q would allow all employees to handle: %s", req.From, strings.Join(response.Conflicts, ", ")))
+		}
+
+		for _, t := range changed {
+			if _, err := r.treatments.UpdateOne(ctx, bson.M{"name": t.Name}, bson.M{
+				"$set": bson.M{
+					"allowedEmployees":   t.AllowedEmployees,
+					"preferredEmployees": t.PreferredEmployees,
+					"resources":          t.Resources,
+				},
+			}); err != nil {
+				return nil, fmt.Errorf("failed to update treatment %q: %w", t.Name, err)
+			}
+		}
+
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.ReplaceReferencesResponse), nil
+}
+
+// replaceReference replaces from with to in list without creating
+// duplicates. If to is empty, from is removed.
+func replaceReference(list []string, from, to string) []string {
+	result := make([]string, 0, len(list))
+
+	for _, v := range list {
+		if v == from {
+			v = to
+		}
+
+		if v == "" || slices.Contains(result, v) {
+			continue
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
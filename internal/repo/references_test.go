@@ -0,0 +1,141 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestReplaceEmployeeReferences(t *testing.T) {
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+				t.Fatalf("failed to create species: %s", err)
+			}
+
+			treatments := []*treatmentv1.Treatment{
+				{Name: "vaccination", Species: []string{"dog"}, AllowedEmployees: []string{"alice", "carol"}, PreferredEmployees: []string{"alice"}},
+				{Name: "checkup", Species: []string{"dog"}, AllowedEmployees: []string{"alice", "bob"}, PreferredEmployees: []string{"alice", "bob"}},
+				{Name: "dental", Species: []string{"dog"}, PreferredEmployees: []string{"alice"}},
+				{Name: "castration", Species: []string{"dog"}, AllowedEmployees: []string{"bob"}},
+			}
+			for _, tr := range treatments {
+				if _, err := r.CreateTreatment(ctx, tr); err != nil {
+					t.Fatalf("failed to create treatment %q: %s", tr.Name, err)
+				}
+			}
+
+			// treatments stored before preferred employees had to be a subset
+			// of the allowed ones
+			if _, err := r.treatments.UpdateOne(ctx, bson.M{"name": "castration"}, bson.M{
+				"$set": bson.M{"preferredEmployees": bson.A{"alice", "dave"}},
+			}); err != nil {
+				t.Fatalf("failed to create legacy treatment: %s", err)
+			}
+
+			if _, err := r.ReplaceReferences(ctx, &treatmentv1alpha.ReplaceReferencesRequest{
+				Kind: treatmentv1alpha.ReferenceKind_REFERENCE_KIND_EMPLOYEE,
+				From: "alice",
+				To:   "bob",
+			}); err != nil {
+				t.Fatalf("failed to replace references: %s", err)
+			}
+
+			expected := map[string][2][]string{
+				"vaccination": {{"bob", "carol"}, {"bob"}},
+				"checkup":     {{"bob"}, {"bob"}},
+				"dental":      {nil, {"bob"}},
+				"castration":  {{"bob"}, {"bob"}},
+			}
+
+			for name, e := range expected {
+				tr, err := r.GetTreatment(ctx, name)
+				if err != nil {
+					t.Fatalf("failed to get treatment %q: %s", name, err)
+				}
+
+				if !slices.Equal(tr.AllowedEmployees, e[0]) {
+					t.Errorf("%s: expected allowed employees %v but got %v", name, e[0], tr.AllowedEmployees)
+				}
+
+				if !slices.Equal(tr.PreferredEmployees, e[1]) {
+					t.Errorf("%s: expected preferred employees %v but got %v", name, e[1], tr.PreferredEmployees)
+				}
+
+				if len(tr.AllowedEmployees) == 0 {
+					continue
+				}
+
+				for _, p := range tr.PreferredEmployees {
+					if !slices.Contains(tr.AllowedEmployees, p) {
+						t.Errorf("%s: preferred employee %q is not allowed", name, p)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestReplaceEmployeeReferencesConflict(t *testing.T) {
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+				t.Fatalf("failed to create species: %s", err)
+			}
+
+			treatments := []*treatmentv1.Treatment{
+				{Name: "surgery", Species: []string{"dog"}, AllowedEmployees: []string{"carol"}, PreferredEmployees: []string{"carol"}},
+				{Name: "vaccination", Species: []string{"dog"}, AllowedEmployees: []string{"alice", "carol"}},
+			}
+			for _, tr := range treatments {
+				if _, err := r.CreateTreatment(ctx, tr); err != nil {
+					t.Fatalf("failed to create treatment %q: %s", tr.Name, err)
+				}
+			}
+
+			req := &treatmentv1alpha.ReplaceReferencesRequest{
+				Kind:   treatmentv1alpha.ReferenceKind_REFERENCE_KIND_EMPLOYEE,
+				From:   "carol",
+				DryRun: true,
+			}
+
+			res, err := r.ReplaceReferences(ctx, req)
+			if err != nil {
+				t.Fatalf("failed to preview reference removal: %s", err)
+			}
+
+			if !slices.Equal(res.Conflicts, []string{"surgery"}) {
+				t.Errorf("expected conflicts %v but got %v", []string{"surgery"}, res.Conflicts)
+			}
+
+			req.DryRun = false
+
+			if _, err := r.ReplaceReferences(ctx, req); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+				t.Fatalf("expected %s but got %v", connect.CodeFailedPrecondition, err)
+			}
+
+			// a rejected removal must not change any treatment
+			for _, name := range []string{"surgery", "vaccination"} {
+				tr, err := r.GetTreatment(ctx, name)
+				if err != nil {
+					t.Fatalf("failed to get treatment %q: %s", name, err)
+				}
+
+				if !slices.Contains(tr.AllowedEmployees, "carol") {
+					t.Errorf("%s: expected %q to still be allowed but got %v", name, "carol", tr.AllowedEmployees)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/validation"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Alias kinds.
+const (
+	AliasKindSpecies   = "species"
+	AliasKindTreatment = "treatment"
+)
+
+// Alias resolves the old name of a renamed species or treatment to
+// the new name until it expires.
+type Alias struct {
+	Kind      string    `bson:"kind"`
+	Name      string    `bson:"name"`
+	Target    string    `bson:"target"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+// RenameSpecies renames a species and rewrites all references. The old name
+// is kept as an alias until expiresAt.
+func (r *Repository) RenameSpecies(ctx context.Context, name, newName string, expiresAt time.Time) (*treatmentv1.Species, error) {
+	ctx, end := observe(ctx, "RenameSpecies")
+	defer end()
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		if err := r.renameDocument(ctx, r.species, name, newName); err != nil {
+			return nil, err
+		}
+
+		if err := r.replaceSpeciesReferences(ctx, name, newName); err != nil {
+			return nil, err
+		}
+
+		if err := r.createAlias(ctx, AliasKindSpecies, name, newName, expiresAt); err != nil {
+			return nil, err
+		}
+
+		s, err := r.findSpecies(ctx, newName)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.ToProto(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1.Species), nil
+}
+
+// RenameTreatment renames a treatment and rewrites all references. The old name
+// is kept as an alias until expiresAt.
+func (r *Repository) RenameTreatment(ctx context.Context, name, newName string, expiresAt time.Time) (*treatmentv1.Treatment, error) {
+	ctx, end := observe(ctx, "RenameTreatment")
+	defer end()
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		if err := r.renameDocument(ctx, r.treatments, name, newName); err != nil {
+			return nil, err
+		}
+
+		if err := r.replaceTreatmentReferences(ctx, name, newName); err != nil {
+			return nil, err
+		}
+
+		if err := r.createAlias(ctx, AliasKindTreatment, name, newName, expiresAt); err != nil {
+			return nil, err
+		}
+
+		t, err := r.findTreatment(ctx, newName)
+		if err != nil {
+			return nil, err
+		}
+
+		return t.ToProto(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1.Treatment), nil
+}
+
+func (r *Repository) renameDocument(ctx context.Context, col *collection, name, newName string) error {
+	if name == newName {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("new name must differ from the current name"))
+	}
+
+	v := new(validation.Violations)
+	v.Name("new_name", newName)
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	res, err := col.UpdateOne(ctx, bson.M{"name": name}, bson.M{
+		"$set": bson.M{
+			"name": newName,
+		},
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%q already exists", newName))
+		}
+
+		return fmt.Errorf("failed to rename %q: %w", name, err)
+	}
+
+	if res.MatchedCount == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("%q not found", name))
+	}
+
+	return nil
+}
+
+// replaceTreatmentReferences replaces all references to the treatment from
+// with the treatment to.
+func (r *Repository) replaceTreatmentReferences(ctx context.Context, from, to string) error {
+	if _, err := r.treatments.UpdateMany(
+		ctx,
+		bson.M{"prerequisites.treatments": from},
+		bson.M{
+			"$set": bson.M{
+				"prerequisites.$[p].treatments.$[t]": to,
+			},
+		},
+		// only descend into prerequisites that reference from since
+		// array updates fail on prerequisites without a treatments array.
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []any{bson.M{"p.treatments": from}, bson.M{"t": from}},
+		}),
+	); err != nil {
+		return fmt.Errorf("failed to update prerequisites: %w", err)
+	}
+
+	if _, err := r.bundles.UpdateMany(
+		ctx,
+		bson.M{"treatments": from},
+		bson.M{
+			"$set": bson.M{
+				"treatments.$[t]": to,
+			},
+		},
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []any{bson.M{"t": from}},
+		}),
+	); err != nil {
+		return fmt.Errorf("failed to update bundles: %w", err)
+	}
+
+	if _, err := r.prices.UpdateMany(ctx, bson.M{"treatment": from}, bson.M{"$set": bson.M{"treatment": to}}); err != nil {
+		return fmt.Errorf("failed to update prices: %w", err)
+	}
+
+	if err := replaceInArray(ctx, r.fixtures, "expectedTreatments", from, to); err != nil {
+		return fmt.Errorf("failed to update detection fixtures: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) createAlias(ctx context.Context, kind, name, target string, expiresAt time.Time) error {
+	// the new name is not an alias anymore
+	if err := r.deleteAlias(ctx, kind, target); err != nil {
+		return err
+	}
+
+	// aliases that pointed to the old name must now point to the new name
+	if _, err := r.aliases.UpdateMany(ctx, bson.M{"kind": kind, "target": name}, bson.M{
+		"$set": bson.M{
+			"target": target,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to update aliases: %w", err)
+	}
+
+	if _, err := r.aliases.UpdateOne(ctx, bson.M{"kind": kind, "name": name}, bson.M{
+		"$set": Alias{
+			Kind:      kind,
+			Name:      name,
+			Target:    target,
+			ExpiresAt: expiresAt,
+		},
+	}, options.Update().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to create alias: %w", err)
+	}
+
+	return nil
+}
+
+// deleteAlias deletes the alias name, if any.
+func (r *Repository) deleteAlias(ctx context.Context, kind, name string) error {
+	if _, err := r.aliases.DeleteMany(ctx, bson.M{"kind": kind, "name": name}); err != nil {
+		return fmt.Errorf("failed to delete alias: %w", err)
+	}
+
+	return nil
+}
+
+// resolveAlias returns the target of the alias name. If there is no active
+// alias, name is returned.
+func (r *Repository) resolveAlias(ctx context.Context, kind, name string) (string, error) {
+	res := r.aliases.FindOne(ctx, bson.M{
+		"kind": kind,
+		"name": name,
+		"expiresAt": bson.M{
+			"$gt": time.Now(),
+		},
+	})
+	if err := res.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return name, nil
+		}
+
+		return "", fmt.Errorf("failed to resolve alias: %w", err)
+	}
+
+	var a Alias
+	if err := res.Decode(&a); err != nil {
+		return "", fmt.Errorf("failed to decode alias database model: %w", err)
+	}
+
+	return a.Target, nil
+}
+
+// resolveAliases replaces all names that are active aliases with their
+// target.
+func (r *Repository) resolveAliases(ctx context.Context, kind string, names []string) ([]string, error) {
+	res, err := r.aliases.Find(ctx, bson.M{
+		"kind": kind,
+		"name": bson.M{
+			"$in": names,
+		},
+		"expiresAt": bson.M{
+			"$gt": time.Now(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve aliases: %w", err)
+	}
+
+	var aliases []Alias
+	if err := res.All(ctx, &aliases); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more alias database models: %w", err)
+	}
+
+	result := make([]string, len(names))
+	copy(result, names)
+
+	for idx, n := range result {
+		for _, a := range aliases {
+			if a.Name == n {
+				result[idx] = a.Target
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestRenameTreatment(t *testing.T) {
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+				t.Fatalf("failed to create species: %s", err)
+			}
+
+			for _, name := range []string{"vaccination", "booster", "checkup"} {
+				if _, err := r.CreateTreatment(ctx, &treatmentv1.Treatment{Name: name, Species: []string{"dog"}}); err != nil {
+					t.Fatalf("failed to create treatment %q: %s", name, err)
+				}
+			}
+
+			if _, err := r.UpdateTreatmentDetails(ctx, &treatmentv1alpha.UpdateTreatmentDetailsRequest{
+				Name: "booster",
+				Details: &treatmentv1alpha.TreatmentDetails{
+					Prerequisites: []*treatmentv1alpha.Prerequisite{
+						{Treatments: []string{"checkup", "vaccination"}},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"prerequisites"}},
+			}); err != nil {
+				t.Fatalf("failed to set prerequisites: %s", err)
+			}
+
+			// prerequisites without a treatments array must not break the
+			// rename of other prerequisites in the same document
+			if _, err := r.treatments.UpdateOne(ctx, bson.M{"name": "checkup"}, bson.M{
+				"$set": bson.M{"prerequisites": bson.A{
+					bson.M{"treatments": nil, "description": "legacy"},
+					bson.M{"treatments": bson.A{"vaccination"}},
+				}},
+			}); err != nil {
+				t.Fatalf("failed to create legacy prerequisite: %s", err)
+			}
+
+			renamed, err := r.RenameTreatment(ctx, "vaccination", "vaccination-dhppi", time.Now().Add(time.Hour))
+			if err != nil {
+				t.Fatalf("failed to rename treatment: %s", err)
+			}
+
+			if renamed.Name != "vaccination-dhppi" {
+				t.Errorf("expected renamed treatment %q but got %q", "vaccination-dhppi", renamed.Name)
+			}
+
+			details, err := r.GetTreatmentDetails(ctx, "booster")
+			if err != nil {
+				t.Fatalf("failed to get treatment details: %s", err)
+			}
+
+			if got := details.GetPrerequisites()[0].GetTreatments(); !slices.Equal(got, []string{"checkup", "vaccination-dhppi"}) {
+				t.Errorf("expected prerequisite to reference the new name but got %v", got)
+			}
+
+			details, err = r.GetTreatmentDetails(ctx, "checkup")
+			if err != nil {
+				t.Fatalf("failed to get treatment details: %s", err)
+			}
+
+			if got := details.GetPrerequisites()[1].GetTreatments(); !slices.Equal(got, []string{"vaccination-dhppi"}) {
+				t.Errorf("expected prerequisite next to a legacy one to reference the new name but got %v", got)
+			}
+
+			// the old name resolves to the renamed treatment until the
+			// alias expires
+			old, err := r.GetTreatment(ctx, "vaccination")
+			if err != nil {
+				t.Fatalf("failed to get treatment by its old name: %s", err)
+			}
+
+			if old.Name != "vaccination-dhppi" {
+				t.Errorf("expected old name to resolve to %q but got %q", "vaccination-dhppi", old.Name)
+			}
+
+			if _, err := r.aliases.UpdateMany(ctx, bson.M{}, bson.M{"$set": bson.M{"expiresAt": time.Now().Add(-time.Second)}}); err != nil {
+				t.Fatalf("failed to expire aliases: %s", err)
+			}
+
+			if _, err := r.GetTreatment(ctx, "vaccination"); connect.CodeOf(err) != connect.CodeNotFound {
+				t.Errorf("expected expired alias to be ignored but got %v", err)
+			}
+		})
+	}
+}
+
+func TestRenameSpecies(t *testing.T) {
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+				t.Fatalf("failed to create species: %s", err)
+			}
+
+			if _, err := r.CreateTreatment(ctx, &treatmentv1.Treatment{Name: "vaccination", Species: []string{"dog"}}); err != nil {
+				t.Fatalf("failed to create treatment: %s", err)
+			}
+
+			if _, err := r.RenameSpecies(ctx, "dog", "canine", time.Now().Add(time.Hour)); err != nil {
+				t.Fatalf("failed to rename species: %s", err)
+			}
+
+			treatment, err := r.GetTreatment(ctx, "vaccination")
+			if err != nil {
+				t.Fatalf("failed to get treatment: %s", err)
+			}
+
+			if !slices.Equal(treatment.Species, []string{"canine"}) {
+				t.Errorf("expected treatment to reference the new species name but got %v", treatment.Species)
+			}
+
+			old, err := r.GetSpecies(ctx, "dog")
+			if err != nil {
+				t.Fatalf("failed to get species by its old name: %s", err)
+			}
+
+			if old.Name != "canine" {
+				t.Errorf("expected old name to resolve to %q but got %q", "canine", old.Name)
+			}
+
+			// a new species may take over the old name
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog", DisplayName: "Dog"}); err != nil {
+				t.Fatalf("failed to create species with the old name: %s", err)
+			}
+
+			dog, err := r.GetSpecies(ctx, "dog")
+			if err != nil {
+				t.Fatalf("failed to get new species: %s", err)
+			}
+
+			if dog.Name != "dog" || dog.DisplayName != "Dog" {
+				t.Errorf("expected the new species but got %q (%q)", dog.Name, dog.DisplayName)
+			}
+
+			list, err := r.ListSpecies(ctx, []string{"dog"})
+			if err != nil {
+				t.Fatalf("failed to list species: %s", err)
+			}
+
+			if len(list) != 1 || list[0].Name != "dog" {
+				names := make([]string, len(list))
+				for idx, s := range list {
+					names[idx] = s.Name
+				}
+
+				t.Errorf("expected the old name to only match the new species but got %v", names)
+			}
+		})
+	}
+}
@@ -3,16 +3,33 @@ package repo
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/tierklinik-dobersberg/treatment-service/internal/metrics"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/tracing"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type Repository struct {
-	species    *mongo.Collection
-	treatments *mongo.Collection
+	species    *collection
+	treatments *collection
+	fixtures   *collection
+	bundles    *collection
+	prices     *collection
+	breeds     *collection
+	aliases    *collection
+	deletions  *collection
+	locks      *mongo.Collection
+	migrations *mongo.Collection
+
+	// transactions is true if the deployment supports multi-document
+	// transactions.
+	transactions bool
 
 	initialTimeRequirement    time.Duration
 	additionalTimeRequirement time.Duration
@@ -20,8 +37,16 @@ type Repository struct {
 
 func NewRepositoryWithClient(ctx context.Context, db *mongo.Database, defaultInitialTimeRequirement, defaultAdditionalTimeRequirement time.Duration) (*Repository, error) {
 	r := &Repository{
-		species:    db.Collection("species"),
-		treatments: db.Collection("treatments"),
+		species:    &collection{db.Collection("species")},
+		treatments: &collection{db.Collection("treatments")},
+		fixtures:   &collection{db.Collection("detectionFixtures")},
+		bundles:    &collection{db.Collection("bundles")},
+		prices:     &collection{db.Collection("prices")},
+		breeds:     &collection{db.Collection("breeds")},
+		aliases:    &collection{db.Collection("aliases")},
+		deletions:  &collection{db.Collection("speciesDeletions")},
+		locks:      db.Collection("locks"),
+		migrations: db.Collection("migrations"),
 
 		initialTimeRequirement:    defaultInitialTimeRequirement,
 		additionalTimeRequirement: defaultAdditionalTimeRequirement,
@@ -31,6 +56,19 @@ func NewRepositoryWithClient(ctx context.Context, db *mongo.Database, defaultIni
 		return nil, err
 	}
 
+	transactions, err := detectTransactionSupport(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	r.transactions = transactions
+
+	if transactions {
+		slog.InfoContext(ctx, "database supports transactions, using multi-document transactions for write operations")
+	} else {
+		slog.WarnContext(ctx, "database does not support transactions (standalone mongod), falling back to serialized write operations that are rolled back on failure")
+	}
+
 	return r, nil
 }
 
@@ -47,53 +85,233 @@ func NewRepository(ctx context.Context, databaseURL, databaseName string, defaul
 	return NewRepositoryWithClient(ctx, cli.Database(databaseName), defaultInitialTimeRequirement, defaultAdditionalTimeRequirement)
 }
 
-func (r *Repository) setup(ctx context.Context) error {
-	if _, err := r.species.Indexes().CreateMany(ctx, []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				{Key: "name", Value: 1},
+// collectionIndexes holds the indexes of a collection.
+type collectionIndexes struct {
+	collection *collection
+	indexes    []mongo.IndexModel
+}
+
+func (r *Repository) indexes() []collectionIndexes {
+	return []collectionIndexes{
+		{r.species, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "name", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
 			},
-			Options: options.Index().SetUnique(true),
-		},
-		{
-			Keys: bson.D{
-				{Key: "matchWords", Value: 1},
+			{
+				Keys: bson.D{
+					{Key: "matchWords", Value: 1},
+				},
 			},
-		},
-	}); err != nil {
-		return fmt.Errorf("failed to create indexes: %w", err)
-	}
-
-	if _, err := r.treatments.Indexes().CreateMany(ctx, []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				{Key: "name", Value: 1},
+		}},
+		{r.treatments, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "name", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{
+					{Key: "species", Value: 1},
+				},
 			},
-			Options: options.Index().SetUnique(true),
-		},
-		{
-			Keys: bson.D{
-				{Key: "species", Value: 1},
+			{
+				Keys: bson.D{
+					{Key: "matchEventText", Value: 1},
+				},
 			},
-		},
-		{
-			Keys: bson.D{
-				{Key: "matchEventText", Value: 1},
+		}},
+		{r.fixtures, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "name", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
 			},
-		},
-	}); err != nil {
-		return fmt.Errorf("failed to create indexes: %w", err)
+		}},
+		{r.bundles, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "name", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{
+					{Key: "treatments", Value: 1},
+				},
+			},
+		}},
+		{r.prices, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "treatment", Value: 1},
+					{Key: "species", Value: 1},
+					{Key: "validFrom", Value: -1},
+				},
+			},
+		}},
+		{r.breeds, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "name", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{
+					{Key: "species", Value: 1},
+				},
+			},
+		}},
+		{r.aliases, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "kind", Value: 1},
+					{Key: "name", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				// expired aliases are removed by mongodb
+				Keys: bson.D{
+					{Key: "expiresAt", Value: 1},
+				},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			},
+		}},
+		{r.deletions, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "species", Value: 1},
+					{Key: "deletedAt", Value: -1},
+				},
+			},
+		}},
 	}
+}
 
-	return nil
+func (r *Repository) setup(ctx context.Context) error {
+	for _, ci := range r.indexes() {
+		if _, err := ci.collection.Indexes().CreateMany(ctx, ci.indexes); err != nil {
+			return fmt.Errorf("failed to create indexes: %w", err)
+		}
+	}
+
+	return r.migrate(ctx)
 }
 
-func (r *Repository) withTransaction(ctx context.Context, fn func(mongo.SessionContext) (any, error)) (any, error) {
-	session, err := r.treatments.Database().Client().StartSession()
-	if err != nil {
-		return nil, fmt.Errorf("failed to start transaction: %w", err)
+// MissingIndexes returns a description of all indexes that are expected but
+// do not exist.
+func (r *Repository) MissingIndexes(ctx context.Context) ([]string, error) {
+	var missing []string
+
+	for _, ci := range r.indexes() {
+		specs, err := ci.collection.Indexes().ListSpecifications(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list indexes of %s: %w", ci.collection.Name(), err)
+		}
+
+		for _, idx := range ci.indexes {
+			keys := idx.Keys.(bson.D)
+
+			if !slices.ContainsFunc(specs, func(spec *mongo.IndexSpecification) bool {
+				return indexKeysEqual(spec.KeysDocument, keys)
+			}) {
+				names := make([]string, len(keys))
+				for i, k := range keys {
+					names[i] = k.Key
+				}
+
+				missing = append(missing, ci.collection.Name()+"("+strings.Join(names, ",")+")")
+			}
+		}
+	}
+
+	return missing, nil
+}
+
+func indexKeysEqual(doc bson.Raw, keys bson.D) bool {
+	elems, err := doc.Elements()
+	if err != nil || len(elems) != len(keys) {
+		return false
 	}
-	defer session.EndSession(ctx)
 
-	return session.WithTransaction(ctx, fn)
+	for idx, e := range elems {
+		direction, ok := e.Value().AsInt64OK()
+		if !ok || e.Key() != keys[idx].Key {
+			return false
+		}
+
+		// keys are declared with plain integers but might use any integer
+		// type. Other key types (e.g. text indexes) are never equal.
+		expected, ok := indexDirection(keys[idx].Value)
+		if !ok || direction != expected {
+			return false
+		}
+	}
+
+	return true
+}
+
+func indexDirection(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// Ping checks the connectivity to the database.
+func (r *Repository) Ping(ctx context.Context) error {
+	return r.treatments.Database().Client().Ping(ctx, nil)
+}
+
+// CountCatalog returns the number of catalog items by kind.
+func (r *Repository) CountCatalog(ctx context.Context) (map[string]int64, error) {
+	counts := []struct {
+		kind   string
+		col    *collection
+		filter bson.M
+	}{
+		{"species", r.species, bson.M{}},
+		{"treatments", r.treatments, bson.M{"template": bson.M{"$ne": true}}},
+		{"templates", r.treatments, bson.M{"template": true}},
+		{"breeds", r.breeds, bson.M{}},
+		{"bundles", r.bundles, bson.M{}},
+		{"prices", r.prices, bson.M{}},
+	}
+
+	result := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		n, err := c.col.CountDocuments(ctx, c.filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", c.kind, err)
+		}
+
+		result[c.kind] = n
+	}
+
+	return result, nil
+}
+
+// observe starts a span for the repository method and records its duration
+// once the returned function is called.
+func observe(ctx context.Context, method string) (context.Context, func()) {
+	start := time.Now()
+
+	spanCtx, span := tracing.Tracer().Start(ctx, "repo."+method)
+
+	return spanCtx, func() {
+		span.End()
+		metrics.ObserveRepository(method, start)
+	}
 }
@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestIndexKeysEqual(t *testing.T) {
+	spec, err := bson.Marshal(bson.D{
+		{Key: "treatment", Value: int32(1)},
+		{Key: "validFrom", Value: int32(-1)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		keys     bson.D
+		expected bool
+	}{
+		{"equal", bson.D{{Key: "treatment", Value: 1}, {Key: "validFrom", Value: -1}}, true},
+		{"int64", bson.D{{Key: "treatment", Value: int64(1)}, {Key: "validFrom", Value: int64(-1)}}, true},
+		{"direction", bson.D{{Key: "treatment", Value: 1}, {Key: "validFrom", Value: 1}}, false},
+		{"order", bson.D{{Key: "validFrom", Value: -1}, {Key: "treatment", Value: 1}}, false},
+		{"length", bson.D{{Key: "treatment", Value: 1}}, false},
+		{"text index", bson.D{{Key: "treatment", Value: "text"}, {Key: "validFrom", Value: -1}}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := indexKeysEqual(spec, c.keys); got != c.expected {
+				t.Errorf("expected %t but got %t", c.expected, got)
+			}
+		})
+	}
+}
@@ -4,59 +4,115 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"maps"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/validation"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/protobuf/proto"
 )
 
 func (r *Repository) CreateSpecies(ctx context.Context, s *treatmentv1.Species) (*treatmentv1.Species, error) {
+	ctx, end := observe(ctx, "CreateSpecies")
+	defer end()
+
 	result := proto.Clone(s).(*treatmentv1.Species)
 
+	v := validation.Species(s)
+	v.Name("name", s.Name)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
 	model := SpeciesFromProto(s)
 
 	if model.DisplayName == "" {
 		model.DisplayName = model.Name
 	}
 
-	if _, err := r.species.InsertOne(ctx, model); err != nil {
-		return nil, fmt.Errorf("failed to persist species to database: %w", err)
+	if _, err := r.withTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+		if _, err := r.species.InsertOne(sc, model); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("species with name %q already exists", model.Name))
+			}
+
+			return nil, fmt.Errorf("failed to persist species to database: %w", err)
+		}
+
+		// the name now belongs to the new species and must no longer
+		// resolve to a renamed one
+		return nil, r.deleteAlias(sc, AliasKindSpecies, model.Name)
+	}); err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
 
 func (r *Repository) GetSpecies(ctx context.Context, name string) (*treatmentv1.Species, error) {
+	ctx, end := observe(ctx, "GetSpecies")
+	defer end()
+
+	m, err := r.findSpecies(ctx, name)
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// the species might have been renamed
+		target, aerr := r.resolveAlias(ctx, AliasKindSpecies, name)
+		if aerr != nil {
+			return nil, aerr
+		}
+
+		if target != name {
+			m, err = r.findSpecies(ctx, target)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m.ToProto(), nil
+}
+
+func (r *Repository) findSpecies(ctx context.Context, name string) (Species, error) {
 	res := r.species.FindOne(ctx, bson.M{"name": name})
 	if err := res.Err(); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("species not found"))
+			return Species{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("species not found"))
 		}
 
-		return nil, err
+		return Species{}, err
 	}
 
 	var m Species
 	if err := res.Decode(&m); err != nil {
-		return nil, fmt.Errorf("failed to decode model: %w", err)
+		return Species{}, fmt.Errorf("failed to decode model: %w", err)
 	}
 
-	return m.ToProto(), nil
+	return m, nil
 }
 
 func (r *Repository) ListSpecies(ctx context.Context, names []string) ([]*treatmentv1.Species, error) {
+	ctx, end := observe(ctx, "ListSpecies")
+	defer end()
+
 	filter := bson.M{}
 
 	if len(names) > 0 {
+		// include the new names of renamed species
+		resolved, err := r.resolveAliases(ctx, AliasKindSpecies, names)
+		if err != nil {
+			return nil, err
+		}
+
 		filter["name"] = bson.M{
-			"$in": names,
+			"$in": append(slices.Clone(names), resolved...),
 		}
 	}
 
@@ -79,6 +135,9 @@ func (r *Repository) ListSpecies(ctx context.Context, names []string) ([]*treatm
 }
 
 func (r *Repository) UpdateSpecies(ctx context.Context, upd *treatmentv1.UpdateSpeciesRequest) (*treatmentv1.Species, error) {
+	ctx, end := observe(ctx, "UpdateSpecies")
+	defer end()
+
 	paths := []string{"display_name", "request_castration_status", "match_words", "icon"}
 
 	if p := upd.GetUpdateMask().GetPaths(); len(p) > 0 {
@@ -86,6 +145,7 @@ func (r *Repository) UpdateSpecies(ctx context.Context, upd *treatmentv1.UpdateS
 	}
 
 	updateModel := bson.M{}
+	v := new(validation.Violations)
 
 	for _, p := range paths {
 		switch p {
@@ -97,24 +157,25 @@ func (r *Repository) UpdateSpecies(ctx context.Context, upd *treatmentv1.UpdateS
 
 		case "match_words":
 			updateModel["matchWords"] = upd.Species.MatchWords
+			v.Unique("species.match_words", upd.Species.MatchWords)
 
 		case "name":
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a species name cannot be updated"))
 
 		case "icon":
 			if upd.Species.Icon != nil {
-				updateModel["icon"] = upd.Species.Icon.Data
+				updateModel["iconData"] = upd.Species.Icon.Data
 				updateModel["iconType"] = uint8(upd.Species.Icon.Type)
 			} else {
-				updateModel["icon"] = []byte(nil)
+				updateModel["iconData"] = []byte(nil)
 				updateModel["iconType"] = uint8(0)
 			}
 
 		case "icon.data":
 			if upd.Species.Icon != nil {
-				updateModel["icon"] = upd.Species.Icon.Data
+				updateModel["iconData"] = upd.Species.Icon.Data
 			} else {
-				updateModel["icon"] = []byte(nil)
+				updateModel["iconData"] = []byte(nil)
 				updateModel["iconType"] = uint8(0)
 			}
 
@@ -122,7 +183,7 @@ func (r *Repository) UpdateSpecies(ctx context.Context, upd *treatmentv1.UpdateS
 			if upd.Species.Icon != nil {
 				updateModel["iconType"] = uint8(upd.Species.Icon.Type)
 			} else {
-				updateModel["icon"] = []byte(nil)
+				updateModel["iconData"] = []byte(nil)
 				updateModel["iconType"] = uint8(0)
 			}
 
@@ -131,6 +192,10 @@ func (r *Repository) UpdateSpecies(ctx context.Context, upd *treatmentv1.UpdateS
 		}
 	}
 
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
 	res := r.species.FindOneAndUpdate(ctx, bson.M{"name": upd.Name}, bson.M{"$set": updateModel}, options.FindOneAndUpdate().SetReturnDocument(options.After))
 	if err := res.Err(); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
@@ -148,53 +213,64 @@ func (r *Repository) UpdateSpecies(ctx context.Context, upd *treatmentv1.UpdateS
 	return m.ToProto(), nil
 }
 
-func (r *Repository) DeleteSpecies(ctx context.Context, name string) error {
-	_, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
-		// first, find all treatments that have name listed on only contain one element
-		res, err := r.treatments.Find(ctx, bson.M{
-			"species": bson.M{
-				"$in":   []string{name},
-				"$size": 1,
-			},
-		})
+// DeleteSpecies deletes a species using the given policy and records the
+// deletion.
+func (r *Repository) DeleteSpecies(ctx context.Context, name string, policy treatmentv1alpha.DeletePolicy, deletedBy string) (*treatmentv1alpha.DeleteSpeciesImpact, error) {
+	ctx, end := observe(ctx, "DeleteSpecies")
+	defer end()
+
+	result, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+		impact, err := r.PreviewDeleteSpecies(ctx, name, policy)
 		if err != nil {
-			return nil, fmt.Errorf("failed to find treatments refering to species: %w", err)
+			return nil, err
 		}
 
-		var docs []struct {
-			ID primitive.ObjectID `bson:"_id"`
-		}
-		if err := res.All(ctx, &docs); err != nil {
-			return nil, fmt.Errorf("failed to decode one or more treatment databsae models: %w", err)
+		if len(impact.Violations) > 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("species %q cannot be deleted: %s", name, strings.Join(impact.Violations, ", ")))
 		}
 
-		ids := make([]primitive.ObjectID, len(docs))
-		for idx, i := range docs {
-			ids[idx] = i.ID
-		}
+		if impact.Policy == treatmentv1alpha.DeletePolicy_DELETE_POLICY_CASCADE {
+			// remove all treatments that would not have any species defined after removal
+			if res, err := r.treatments.DeleteMany(ctx, bson.M{"name": bson.M{"$in": impact.DeletedTreatments}}); err != nil || res.DeletedCount != int64(len(impact.DeletedTreatments)) {
+				if err != nil {
+					return nil, fmt.Errorf("failed to delete treatments: %w", err)
+				}
 
-		// now, remove all treatments that would not have any species defined after removal
-		if res, err := r.treatments.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil || res.DeletedCount != int64(len(ids)) {
-			if err != nil {
-				return nil, fmt.Errorf("failed to delete treatments: %w", err)
+				return nil, fmt.Errorf("unexpected delete-count result when deleting treatments")
 			}
 
-			return nil, fmt.Errorf("unexpected delete-count result when deleting treatments")
+			// make sure the remaining treatments do not refer to deleted ones
+			if err := r.removeTreatmentReferences(ctx, impact.DeletedTreatments); err != nil {
+				return nil, err
+			}
 		}
 
-		// finally, remove the species from all remaining treatments
+		// remove the species from all remaining treatments
 		if _, err := r.treatments.UpdateMany(
 			ctx,
 			bson.M{},
 			bson.M{
 				"$pull": bson.M{
 					"species": name,
+					"speciesPreparationInstructions": bson.M{
+						"species": name,
+					},
 				},
 			},
 		); err != nil {
 			return nil, fmt.Errorf("failed to remove species from treatments: %w", err)
 		}
 
+		// breeds cannot exist without their species
+		if _, err := r.breeds.DeleteMany(ctx, bson.M{"species": name}); err != nil {
+			return nil, fmt.Errorf("failed to delete species breeds: %w", err)
+		}
+
+		// species specific prices are not needed anymore
+		if _, err := r.prices.DeleteMany(ctx, bson.M{"species": name}); err != nil {
+			return nil, fmt.Errorf("failed to delete species prices: %w", err)
+		}
+
 		// now, there are not more treatments that refer to the species so we can finally delete it
 		if d, err := r.species.DeleteOne(ctx, bson.M{"name": name}); err != nil || d.DeletedCount != 1 {
 			if err != nil {
@@ -204,28 +280,181 @@ func (r *Repository) DeleteSpecies(ctx context.Context, name string) error {
 			return nil, fmt.Errorf("unexpected delete-count result when deleting the species")
 		}
 
+		if _, err := r.deletions.InsertOne(ctx, SpeciesDeletionFromProto(impact, deletedBy, time.Now())); err != nil {
+			return nil, fmt.Errorf("failed to record species deletion: %w", err)
+		}
+
 		// done
-		return nil, nil
+		return impact, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*treatmentv1alpha.DeleteSpeciesImpact), nil
+}
+
+// PreviewDeleteSpecies returns the impact of deleting a species using the
+// given policy.
+func (r *Repository) PreviewDeleteSpecies(ctx context.Context, name string, policy treatmentv1alpha.DeletePolicy) (*treatmentv1alpha.DeleteSpeciesImpact, error) {
+	ctx, end := observe(ctx, "PreviewDeleteSpecies")
+	defer end()
+
+	if policy == treatmentv1alpha.DeletePolicy_DELETE_POLICY_UNSPECIFIED {
+		policy = treatmentv1alpha.DeletePolicy_DELETE_POLICY_RESTRICT
+	}
+
+	if _, err := r.findSpecies(ctx, name); err != nil {
+		return nil, err
+	}
+
+	impact := &treatmentv1alpha.DeleteSpeciesImpact{
+		Species: name,
+		Policy:  policy,
+	}
+
+	// species groups must be emptied before they can be deleted
+	children, err := r.species.CountDocuments(ctx, bson.M{"parent": name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count child species: %w", err)
+	}
+
+	if children > 0 {
+		impact.Violations = append(impact.Violations, fmt.Sprintf("species still has %d child species", children))
+	}
+
+	treatments, err := r.findTreatmentModels(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"species": name},
+			bson.M{"speciesPreparationInstructions.species": name},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	impact.DeletedBreeds, err = r.distinctNames(ctx, r.breeds, bson.M{"species": name})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == treatmentv1alpha.DeletePolicy_DELETE_POLICY_RESTRICT {
+		for _, t := range treatments {
+			impact.Violations = append(impact.Violations, fmt.Sprintf("referenced by treatment %q", t.Name))
+		}
+
+		for _, b := range impact.DeletedBreeds {
+			impact.Violations = append(impact.Violations, fmt.Sprintf("referenced by breed %q", b))
+		}
+
+		prices, err := r.prices.CountDocuments(ctx, bson.M{"species": name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to count species prices: %w", err)
+		}
+
+		if prices > 0 {
+			impact.Violations = append(impact.Violations, fmt.Sprintf("referenced by %d prices", prices))
+		}
+
+		return impact, nil
+	}
+
+	for _, t := range treatments {
+		if policy == treatmentv1alpha.DeletePolicy_DELETE_POLICY_CASCADE && len(t.Species) == 1 && t.Species[0] == name {
+			impact.DeletedTreatments = append(impact.DeletedTreatments, t.Name)
+		} else {
+			impact.ModifiedTreatments = append(impact.ModifiedTreatments, t.Name)
+		}
+	}
+
+	if len(impact.DeletedTreatments) > 0 {
+		dependents, err := r.distinctNames(ctx, r.treatments, bson.M{
+			"prerequisites.treatments": bson.M{"$in": impact.DeletedTreatments},
+			"name":                     bson.M{"$nin": impact.DeletedTreatments},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range dependents {
+			if !slices.Contains(impact.ModifiedTreatments, d) {
+				impact.ModifiedTreatments = append(impact.ModifiedTreatments, d)
+			}
+		}
+
+		impact.ModifiedBundles, impact.DeletedBundles, err = r.bundleMemberRemoval(ctx, impact.DeletedTreatments)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// species prices are deleted with the species and treatment prices
+	// with their treatment
+	impact.DeletedPrices, err = r.priceIDs(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"species": name},
+			bson.M{"treatment": bson.M{"$in": impact.DeletedTreatments}},
+		},
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return impact, nil
 }
 
-func (r *Repository) DetectSpecies(ctx context.Context, req *treatmentv1.DetectSpeciesRequest) ([]*treatmentv1.Species, error) {
+func (r *Repository) ListSpeciesDeletions(ctx context.Context, species string) ([]*treatmentv1alpha.SpeciesDeletion, error) {
+	ctx, end := observe(ctx, "ListSpeciesDeletions")
+	defer end()
+
+	filter := bson.M{}
+	if species != "" {
+		filter["species"] = species
+	}
+
+	res, err := r.deletions.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "deletedAt", Value: -1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform find operation: %w", err)
+	}
+
+	var m []SpeciesDeletion
+	if err := res.All(ctx, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode one or more species deletion database models: %w", err)
+	}
+
+	result := make([]*treatmentv1alpha.SpeciesDeletion, len(m))
+	for idx, d := range m {
+		result[idx] = d.ToProto()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) DetectSpecies(ctx context.Context, req *treatmentv1.DetectSpeciesRequest, opts textmatch.Options) ([]*treatmentv1.Species, error) {
+	ctx, end := observe(ctx, "DetectSpecies")
+	defer end()
+
 	species, err := r.ListSpecies(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Find distinct matches and track how often a species matches a given value
+	breeds, err := r.findBreeds(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	speciesByName := data.IndexSlice(species, func(s *treatmentv1.Species) string { return s.Name })
+
+	// Find distinct matches and track how well a species matches the given values
 	// so we can sort based on the "best-match".
 	// TODO(ppacher): should we consider the length of the MatchWords to increase
 	// the best-match probability?
 	matches := make(map[string]*treatmentv1.Species)
-	matchCount := make(map[string]int)
+	scores := make(map[string]*textmatch.Score)
 
 	for _, v := range req.Values {
-		l := strings.ToLower(v)
+		text := textmatch.NewText(v)
 
 		for _, s := range species {
 			for _, m := range s.MatchWords {
@@ -233,23 +462,58 @@ func (r *Repository) DetectSpecies(ctx context.Context, req *treatmentv1.DetectS
 				// that can specify if m should be surounded by whitespaces, start/end of string or
 				// may include other values (like .*) in the future.
 				// this would also require a change to how we calculate the match-count.
-				if strings.Contains(l, strings.ToLower(m)) {
-					matches[s.Name] = s
-					matchCount[s.Name]++
+				match, ok := text.Match(m, opts)
+				if !ok {
+					continue
+				}
+
+				if _, ok := scores[s.Name]; !ok {
+					scores[s.Name] = new(textmatch.Score)
 				}
+
+				matches[s.Name] = s
+				scores[s.Name].Add(match)
+			}
+		}
+
+		// a matching breed also counts for its species
+		for _, b := range breeds {
+			s, ok := speciesByName[b.Species]
+			if !ok {
+				continue
+			}
+
+			for _, m := range b.MatchWords {
+				match, ok := text.Match(m, opts)
+				if !ok {
+					continue
+				}
+
+				if _, ok := scores[s.Name]; !ok {
+					scores[s.Name] = new(textmatch.Score)
+				}
+
+				matches[s.Name] = s
+				scores[s.Name].Add(match)
 			}
 		}
 	}
 
-	result := slices.Collect(maps.Values(matches))
+	// collect in the order of the species list so the result is stable
+	// for species with an equal score.
+	result := make([]*treatmentv1.Species, 0, len(matches))
+	for _, s := range species {
+		if m, ok := matches[s.Name]; ok {
+			result = append(result, m)
+		}
+	}
 
-	// sort in descending order to ensure the species with the most
-	// matches are on top.
+	// sort in descending order to ensure the species with the best
+	// matches are on top. Exact matches always outrank fuzzy ones.
 	//
-	// TODO(ppacher): we might consider exposing the match-count (or a better metric in the future)
-	// via the API.
+	// TODO(ppacher): we might consider exposing the match-score via the API.
 	slices.SortStableFunc(result, func(a, b *treatmentv1.Species) int {
-		return matchCount[b.Name] - matchCount[a.Name]
+		return scores[b.Name].Compare(*scores[a.Name])
 	})
 
 	return result, nil
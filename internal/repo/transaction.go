@@ -0,0 +1,232 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tierklinik-dobersberg/treatment-service/internal/tracing"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// writeLockID is the ID of the lock document that serializes write
+	// operations on deployments without transaction support.
+	writeLockID = "write"
+
+	// writeLockLease is the time after which a write lock is considered
+	// abandoned and may be taken over by another writer.
+	writeLockLease = 30 * time.Second
+
+	// writeLockRetry is the interval between attempts to acquire a held
+	// write lock.
+	writeLockRetry = 50 * time.Millisecond
+)
+
+// helloResponse holds the fields of the hello command that are required to
+// determine whether a deployment supports transactions.
+type helloResponse struct {
+	SetName string `bson:"setName"`
+	Msg     string `bson:"msg"`
+}
+
+// supportsTransactions reports whether the deployment described by h
+// supports multi-document transactions. This is true for replica sets and
+// sharded clusters but not for a standalone mongod.
+func supportsTransactions(h helloResponse) bool {
+	return h.SetName != "" || h.Msg == "isdbgrid"
+}
+
+func detectTransactionSupport(ctx context.Context, db *mongo.Database) (bool, error) {
+	var h helloResponse
+	if err := db.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&h); err != nil {
+		return false, fmt.Errorf("failed to run hello command: %w", err)
+	}
+
+	return supportsTransactions(h), nil
+}
+
+func (r *Repository) withTransaction(ctx context.Context, fn func(mongo.SessionContext) (any, error)) (any, error) {
+	// if we are already part of a transaction, just re-use the existing session.
+	// ctx might wrap the session context (e.g. with a span or a deadline)
+	// so the session is looked up instead of asserting the context type.
+	if session := mongo.SessionFromContext(ctx); session != nil {
+		return fn(mongo.NewSessionContext(ctx, session))
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "repo.transaction", trace.WithAttributes(
+		attribute.Bool("db.transactions", r.transactions),
+	))
+	defer span.End()
+
+	session, err := r.treatments.Database().Client().StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	if r.transactions {
+		attempt := 0
+
+		return session.WithTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+			attempt++
+			if attempt > 1 {
+				span.AddEvent("transaction retry", trace.WithAttributes(attribute.Int("attempt", attempt)))
+			}
+
+			return fn(sc)
+		})
+	}
+
+	// standalone deployments do not support transactions so we serialize
+	// all write operations instead and roll back failed operations by
+	// restoring the pre-images of all documents touched by fn.
+	owner, err := r.acquireWriteLock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.releaseWriteLock(ctx, owner)
+
+	span.AddEvent("write lock acquired")
+
+	// the lease is renewed while fn is running. If the lock is lost, fn is
+	// cancelled since other writers might already be modifying the catalog.
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go r.renewWriteLock(lockCtx, owner, cancel, stop)
+
+	j := newJournal()
+
+	result, err := fn(mongo.NewSessionContext(withJournal(lockCtx, j), session))
+
+	// never roll back without holding the lock as this would overwrite the
+	// changes of other writers.
+	if cause := context.Cause(lockCtx); errors.Is(cause, errWriteLockLost) {
+		slog.ErrorContext(ctx, "write lock lost during write operation, changes cannot be rolled back", "error", cause)
+		return nil, fmt.Errorf("failed to complete write operation: %w", cause)
+	}
+
+	if err == nil {
+		return result, nil
+	}
+
+	span.AddEvent("rolling back")
+
+	// roll back even if the operation context has been cancelled
+	rollbackCtx, cancelRollback := context.WithTimeout(context.WithoutCancel(ctx), writeLockLease)
+	defer cancelRollback()
+
+	if rerr := j.rollback(rollbackCtx); rerr != nil {
+		slog.ErrorContext(ctx, "failed to roll back write operation", "error", rerr)
+		return nil, errors.Join(err, fmt.Errorf("failed to roll back: %w", rerr))
+	}
+
+	return nil, err
+}
+
+// errWriteLockLost is the cancellation cause of write operations whose
+// write lock lease could not be renewed in time.
+var errWriteLockLost = errors.New("write lock lost")
+
+// acquireWriteLock blocks until the write lock is acquired or ctx is
+// cancelled. It returns the owner ID required to release the lock.
+func (r *Repository) acquireWriteLock(ctx context.Context) (string, error) {
+	owner := primitive.NewObjectID().Hex()
+
+	for {
+		now := time.Now()
+
+		// the upsert fails with a duplicate key error while an unexpired
+		// lock is held by someone else.
+		_, err := r.locks.UpdateOne(ctx, bson.M{
+			"_id": writeLockID,
+			"expiresAt": bson.M{
+				"$lte": now,
+			},
+		}, bson.M{
+			"$set": bson.M{
+				"owner":     owner,
+				"expiresAt": now.Add(writeLockLease),
+			},
+		}, options.Update().SetUpsert(true))
+		if err == nil {
+			return owner, nil
+		}
+
+		if !mongo.IsDuplicateKeyError(err) {
+			return "", fmt.Errorf("failed to acquire write lock: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("failed to acquire write lock: %w", ctx.Err())
+		case <-time.After(writeLockRetry):
+		}
+	}
+}
+
+// renewWriteLock extends the lease of the write lock held by owner until
+// stop is closed. If the lease cannot be renewed before it expires or the
+// lock has been taken over, cancel is called with errWriteLockLost.
+func (r *Repository) renewWriteLock(ctx context.Context, owner string, cancel context.CancelCauseFunc, stop <-chan struct{}) {
+	ticker := time.NewTicker(writeLockLease / 3)
+	defer ticker.Stop()
+
+	expiresAt := time.Now().Add(writeLockLease)
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		next := time.Now().Add(writeLockLease)
+
+		res, err := r.locks.UpdateOne(ctx, bson.M{"_id": writeLockID, "owner": owner}, bson.M{
+			"$set": bson.M{
+				"expiresAt": next,
+			},
+		})
+
+		switch {
+		case err == nil && res.MatchedCount == 0:
+			cancel(errWriteLockLost)
+			return
+
+		case err == nil:
+			expiresAt = next
+
+		case time.Now().After(expiresAt):
+			cancel(fmt.Errorf("%w: %w", errWriteLockLost, err))
+			return
+
+		default:
+			// try again before the lease expires
+			slog.WarnContext(ctx, "failed to renew write lock", "error", err)
+		}
+	}
+}
+
+func (r *Repository) releaseWriteLock(ctx context.Context, owner string) {
+	// release the lock even if the operation context has been cancelled
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if _, err := r.locks.DeleteOne(ctx, bson.M{"_id": writeLockID, "owner": owner}); err != nil {
+		slog.ErrorContext(ctx, "failed to release write lock, it will expire after the lease time", "lease", writeLockLease, "error", err)
+	}
+}
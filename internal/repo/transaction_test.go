@@ -0,0 +1,423 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestSupportsTransactions(t *testing.T) {
+	cases := []struct {
+		name     string
+		hello    helloResponse
+		expected bool
+	}{
+		{"standalone", helloResponse{}, false},
+		{"replica set", helloResponse{SetName: "rs0"}, true},
+		{"sharded cluster", helloResponse{Msg: "isdbgrid"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := supportsTransactions(c.hello); got != c.expected {
+				t.Errorf("expected %t but got %t", c.expected, got)
+			}
+		})
+	}
+}
+
+// modes returns the write modes to test. Transactions are only tested if
+// the deployment at TEST_MONGODB_URL supports them while the standalone
+// fallback can be tested against any deployment.
+var modes = []struct {
+	name         string
+	transactions bool
+}{
+	{"transactions", true},
+	{"standalone", false},
+}
+
+// newTestRepository returns a repository using a new database at
+// TEST_MONGODB_URL which is dropped when the test finishes. The test is
+// skipped if TEST_MONGODB_URL is not set. "make test" runs the tests
+// against a standalone mongod and a single node replica set using
+// scripts/test-mongodb.sh.
+func newTestRepository(t *testing.T, transactions bool) *Repository {
+	t.Helper()
+
+	url := os.Getenv("TEST_MONGODB_URL")
+	if url == "" {
+		t.Skip("TEST_MONGODB_URL not set, use \"make test\" to run the tests against a standalone mongod and a replica set")
+	}
+
+	ctx := context.Background()
+
+	cli, err := mongo.Connect(ctx, options.Client().ApplyURI(url))
+	if err != nil {
+		t.Fatalf("failed to connect to database: %s", err)
+	}
+
+	db := cli.Database("treatment_service_test_" + primitive.NewObjectID().Hex())
+
+	t.Cleanup(func() {
+		_ = db.Drop(ctx)
+		_ = cli.Disconnect(ctx)
+	})
+
+	r, err := NewRepositoryWithClient(ctx, db, 15*time.Minute, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("failed to create repository: %s", err)
+	}
+
+	if transactions && !r.transactions {
+		t.Skip("database does not support transactions")
+	}
+
+	r.transactions = transactions
+
+	return r
+}
+
+func TestWriteOperations(t *testing.T) {
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+				t.Fatalf("failed to create species: %s", err)
+			}
+
+			if _, err := r.CreateTreatment(ctx, &treatmentv1.Treatment{
+				Name:             "vaccination",
+				Species:          []string{"dog"},
+				AllowedEmployees: []string{"alice"},
+			}); err != nil {
+				t.Fatalf("failed to create treatment: %s", err)
+			}
+
+			if _, err := r.CreateTreatment(ctx, &treatmentv1.Treatment{
+				Name:    "castration",
+				Species: []string{"cat"},
+			}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("expected unknown species to be rejected with %s but got %v", connect.CodeInvalidArgument, err)
+			}
+
+			updated, err := r.UpdateTreatment(ctx, &treatmentv1.UpdateTreatmentRequest{
+				Name:        "vaccination",
+				DisplayName: "Vaccination",
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+			})
+			if err != nil {
+				t.Fatalf("failed to update treatment: %s", err)
+			}
+
+			if updated.DisplayName != "Vaccination" {
+				t.Errorf("expected display name %q but got %q", "Vaccination", updated.DisplayName)
+			}
+
+			// invalid updates must not be written
+			if _, err := r.UpdateTreatment(ctx, &treatmentv1.UpdateTreatmentRequest{
+				Name:               "vaccination",
+				DisplayName:        "Invalid",
+				PreferredEmployees: []string{"bob"},
+				UpdateMask:         &fieldmaskpb.FieldMask{Paths: []string{"display_name", "preferred_employees"}},
+			}); connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("expected invalid update to be rejected with %s but got %v", connect.CodeInvalidArgument, err)
+			}
+
+			current, err := r.GetTreatment(ctx, "vaccination")
+			if err != nil {
+				t.Fatalf("failed to get treatment: %s", err)
+			}
+
+			if current.DisplayName != "Vaccination" {
+				t.Errorf("expected invalid update to be discarded but display name is %q", current.DisplayName)
+			}
+
+			if _, err := r.DeleteSpecies(ctx, "dog", treatmentv1alpha.DeletePolicy_DELETE_POLICY_CASCADE, "test"); err != nil {
+				t.Fatalf("failed to delete species: %s", err)
+			}
+
+			if _, err := r.GetTreatment(ctx, "vaccination"); connect.CodeOf(err) != connect.CodeNotFound {
+				t.Errorf("expected treatment to be deleted together with its only species but got %v", err)
+			}
+		})
+	}
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	const writers = 10
+
+	for _, m := range modes {
+		t.Run(m.name, func(t *testing.T) {
+			r := newTestRepository(t, m.transactions)
+			ctx := context.Background()
+
+			counters := r.treatments.Database().Collection("counters")
+			if _, err := counters.InsertOne(ctx, bson.M{"_id": "counter", "value": 0}); err != nil {
+				t.Fatalf("failed to create counter: %s", err)
+			}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, writers)
+
+			for range writers {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					// read-modify-write cycles must not overlap
+					_, err := r.withTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+						var doc struct {
+							Value int `bson:"value"`
+						}
+
+						if err := counters.FindOne(sc, bson.M{"_id": "counter"}).Decode(&doc); err != nil {
+							return nil, err
+						}
+
+						time.Sleep(10 * time.Millisecond)
+
+						return counters.UpdateOne(sc, bson.M{"_id": "counter"}, bson.M{"$set": bson.M{"value": doc.Value + 1}})
+					})
+
+					errs <- err
+				}()
+			}
+
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				if err != nil {
+					t.Fatalf("failed to increment counter: %s", err)
+				}
+			}
+
+			var doc struct {
+				Value int `bson:"value"`
+			}
+			if err := counters.FindOne(ctx, bson.M{"_id": "counter"}).Decode(&doc); err != nil {
+				t.Fatalf("failed to read counter: %s", err)
+			}
+
+			if doc.Value != writers {
+				t.Errorf("expected counter to be %d but got %d", writers, doc.Value)
+			}
+		})
+	}
+}
+
+func TestWriteLock(t *testing.T) {
+	r := newTestRepository(t, false)
+	ctx := context.Background()
+
+	// a held lock blocks other writers
+	if _, err := r.locks.InsertOne(ctx, bson.M{"_id": writeLockID, "owner": "other", "expiresAt": time.Now().Add(time.Minute)}); err != nil {
+		t.Fatalf("failed to create lock: %s", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+
+	if _, err := r.acquireWriteLock(timeoutCtx); err == nil {
+		t.Fatalf("expected held lock to block acquisition")
+	}
+
+	// an expired lock is taken over
+	if _, err := r.locks.UpdateOne(ctx, bson.M{"_id": writeLockID}, bson.M{"$set": bson.M{"expiresAt": time.Now().Add(-time.Second)}}); err != nil {
+		t.Fatalf("failed to expire lock: %s", err)
+	}
+
+	owner, err := r.acquireWriteLock(ctx)
+	if err != nil {
+		t.Fatalf("failed to acquire expired lock: %s", err)
+	}
+
+	r.releaseWriteLock(ctx, owner)
+
+	if n, err := r.locks.CountDocuments(ctx, bson.M{}); err != nil || n != 0 {
+		t.Errorf("expected lock to be released but got %d lock documents (error: %v)", n, err)
+	}
+}
+
+func TestStandaloneRollback(t *testing.T) {
+	r := newTestRepository(t, false)
+	ctx := context.Background()
+
+	if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog"}); err != nil {
+		t.Fatalf("failed to create species: %s", err)
+	}
+
+	if _, err := r.CreateTreatment(ctx, &treatmentv1.Treatment{Name: "vaccination", DisplayName: "Vaccination", Species: []string{"dog"}}); err != nil {
+		t.Fatalf("failed to create treatment: %s", err)
+	}
+
+	failure := errors.New("failure")
+
+	// a batch that has been applied but whose operation fails afterwards
+	_, err := r.withTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+		if _, err := r.BatchMutateTreatments(sc, []*treatmentv1alpha.TreatmentMutation{
+			{Kind: &treatmentv1alpha.TreatmentMutation_Create{Create: &treatmentv1.Treatment{Name: "castration", Species: []string{"dog"}}}},
+			{Kind: &treatmentv1alpha.TreatmentMutation_Update{Update: &treatmentv1.UpdateTreatmentRequest{
+				Name:        "vaccination",
+				DisplayName: "Changed",
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+			}}},
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := r.DeleteTreatment(sc, "vaccination"); err != nil {
+			return nil, err
+		}
+
+		return nil, failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected the operation to fail with %q but got %v", failure, err)
+	}
+
+	if _, err := r.GetTreatment(ctx, "castration"); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected created treatment to be rolled back but got %v", err)
+	}
+
+	vaccination, err := r.GetTreatment(ctx, "vaccination")
+	if err != nil {
+		t.Fatalf("expected deleted treatment to be restored but got %s", err)
+	}
+
+	if vaccination.DisplayName != "Vaccination" {
+		t.Errorf("expected update to be rolled back but display name is %q", vaccination.DisplayName)
+	}
+
+	if n, err := r.locks.CountDocuments(ctx, bson.M{}); err != nil || n != 0 {
+		t.Errorf("expected lock to be released but got %d lock documents (error: %v)", n, err)
+	}
+}
+
+func TestStandaloneFixtureGuardReject(t *testing.T) {
+	r := newTestRepository(t, false)
+	ctx := context.Background()
+
+	if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: "dog", MatchWords: []string{"Hund"}}); err != nil {
+		t.Fatalf("failed to create species: %s", err)
+	}
+
+	if _, err := r.CreateDetectionFixture(ctx, &treatmentv1alpha.DetectionFixture{
+		Name:            "dog",
+		Values:          []string{"Hund"},
+		ExpectedSpecies: []string{"dog"},
+	}); err != nil {
+		t.Fatalf("failed to create detection fixture: %s", err)
+	}
+
+	_, regressions, err := r.GuardDetectionFixtures(ctx, textmatch.Options{}, true, func(ctx context.Context) (any, error) {
+		return r.UpdateSpecies(ctx, &treatmentv1.UpdateSpeciesRequest{
+			Name:       "dog",
+			Species:    &treatmentv1.Species{},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"match_words"}},
+		})
+	})
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected regression to be rejected with %s but got %v", connect.CodeFailedPrecondition, err)
+	}
+
+	if len(regressions) != 1 || regressions[0].Name != "dog" {
+		t.Errorf("expected fixture %q to regress but got %v", "dog", regressions)
+	}
+
+	dog, err := r.GetSpecies(ctx, "dog")
+	if err != nil {
+		t.Fatalf("failed to get species: %s", err)
+	}
+
+	if !slices.Equal(dog.MatchWords, []string{"Hund"}) {
+		t.Errorf("expected rejected update to be rolled back but match words are %v", dog.MatchWords)
+	}
+}
+
+func TestJournal(t *testing.T) {
+	r := newTestRepository(t, false)
+	ctx := context.Background()
+
+	for _, name := range []string{"dog", "cat", "horse"} {
+		if _, err := r.CreateSpecies(ctx, &treatmentv1.Species{Name: name}); err != nil {
+			t.Fatalf("failed to create species %q: %s", name, err)
+		}
+	}
+
+	displayNames := func() map[string]string {
+		list, err := r.ListSpecies(ctx, nil)
+		if err != nil {
+			t.Fatalf("failed to list species: %s", err)
+		}
+
+		m := make(map[string]string, len(list))
+		for _, s := range list {
+			m[s.Name] = s.DisplayName
+		}
+
+		return m
+	}
+
+	before := displayNames()
+
+	j := newJournal()
+	jctx := withJournal(ctx, j)
+
+	if _, err := r.species.UpdateOne(jctx, bson.M{"name": "dog"}, bson.M{"$set": bson.M{"displayName": "Dog"}}); err != nil {
+		t.Fatalf("failed to update species: %s", err)
+	}
+
+	// the pre-image is only recorded before the first modification
+	if _, err := r.species.UpdateOne(jctx, bson.M{"name": "dog"}, bson.M{"$set": bson.M{"displayName": "Hund"}}); err != nil {
+		t.Fatalf("failed to update species: %s", err)
+	}
+
+	if _, err := r.species.DeleteOne(jctx, bson.M{"name": "cat"}); err != nil {
+		t.Fatalf("failed to delete species: %s", err)
+	}
+
+	if _, err := r.species.InsertOne(jctx, bson.M{"name": "cat", "displayName": "New cat"}); err != nil {
+		t.Fatalf("failed to create species: %s", err)
+	}
+
+	if _, err := r.aliases.UpdateOne(jctx, bson.M{"kind": "species", "name": "kitty"}, bson.M{"$set": bson.M{"target": "cat"}}, options.Update().SetUpsert(true)); err != nil {
+		t.Fatalf("failed to upsert alias: %s", err)
+	}
+
+	// only touched documents are recorded
+	if len(j.entries) != 4 {
+		t.Fatalf("expected 4 journal entries but got %d", len(j.entries))
+	}
+
+	if err := j.rollback(ctx); err != nil {
+		t.Fatalf("failed to roll back: %s", err)
+	}
+
+	if got := displayNames(); !maps.Equal(got, before) {
+		t.Errorf("expected species %v to be restored but got %v", before, got)
+	}
+
+	if n, err := r.aliases.CountDocuments(ctx, bson.M{}); err != nil || n != 0 {
+		t.Errorf("expected upserted alias to be removed but got %d aliases (error: %v)", n, err)
+	}
+}
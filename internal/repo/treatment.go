@@ -2,20 +2,25 @@ package repo
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/bufbuild/connect-go"
 	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
 	"github.com/tierklinik-dobersberg/apis/pkg/data"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/validation"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func (r *Repository) CreateTreatment(ctx context.Context, t *treatmentv1.Treatment) (*treatmentv1.Treatment, error) {
-	if err := r.validateTreatmentEmployees(t); err != nil {
+	ctx, end := observe(ctx, "CreateTreatment")
+	defer end()
+
+	v := validation.Treatment(t)
+	v.Name("name", t.Name)
+	if err := v.Err(); err != nil {
 		return nil, err
 	}
 
@@ -40,12 +45,18 @@ func (r *Repository) CreateTreatment(ctx context.Context, t *treatmentv1.Treatme
 		_, err := r.treatments.InsertOne(ctx, model)
 		if err != nil {
 			if mongo.IsDuplicateKeyError(err) {
-				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+				return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("treatment with name %q already exists", model.Name))
 			}
 
 			return nil, fmt.Errorf("failed to persist treatment: %w", err)
 		}
 
+		// the name now belongs to the new treatment and must no longer
+		// resolve to a renamed one
+		if err := r.deleteAlias(ctx, AliasKindTreatment, model.Name); err != nil {
+			return nil, err
+		}
+
 		return model.ToProto(), nil
 	})
 	if err != nil {
@@ -56,34 +67,77 @@ func (r *Repository) CreateTreatment(ctx context.Context, t *treatmentv1.Treatme
 }
 
 func (r *Repository) GetTreatment(ctx context.Context, name string) (*treatmentv1.Treatment, error) {
-	res := r.treatments.FindOne(ctx, bson.M{"name": name})
-	if err := res.Err(); err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", name))
+	ctx, end := observe(ctx, "GetTreatment")
+	defer end()
+
+	t, err := r.findTreatment(ctx, name)
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// the treatment might have been renamed
+		target, aerr := r.resolveAlias(ctx, AliasKindTreatment, name)
+		if aerr != nil {
+			return nil, aerr
 		}
 
-		return nil, err
+		if target != name {
+			t, err = r.findTreatment(ctx, target)
+		}
 	}
-
-	var t Treatment
-	if err := res.Decode(&t); err != nil {
-		return nil, fmt.Errorf("failed to decode treatment database model: %w", err)
+	if err != nil {
+		return nil, err
 	}
 
 	return t.ToProto(), nil
 }
 
 func (r *Repository) ListTreatments(ctx context.Context, search string) ([]*treatmentv1.Treatment, error) {
+	ctx, end := observe(ctx, "ListTreatments")
+	defer end()
+
 	return r.findTreatments(ctx, bson.M{})
 }
 
 func (r *Repository) QuerySpecies(ctx context.Context, species []string, displayName string) ([]*treatmentv1.Treatment, error) {
-	all, err := r.findTreatments(ctx, bson.M{})
+	ctx, end := observe(ctx, "QuerySpecies")
+	defer end()
+
+	all, err := r.queryTreatments(ctx, species, displayName, false)
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]*treatmentv1.Treatment, 0, len(all))
+	result := make([]*treatmentv1.Treatment, len(all))
+	for idx, t := range all {
+		result[idx] = t.ToProto()
+	}
+
+	return result, nil
+}
+
+// queryTreatments returns all treatments applicable to one of species
+// that match displayName. Template treatments are only included if
+// includeTemplates is set.
+func (r *Repository) queryTreatments(ctx context.Context, species []string, displayName string, includeTemplates bool) ([]Treatment, error) {
+	filter := bson.M{}
+	if !includeTemplates {
+		filter["template"] = bson.M{"$ne": true}
+	}
+
+	all, err := r.findTreatmentModels(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// treatments assigned to a species group apply to all of its descendants
+	if len(species) > 0 {
+		tree, err := r.loadSpeciesTree(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		species = tree.WithAncestors(species)
+	}
+
+	result := make([]Treatment, 0, len(all))
 	for _, t := range all {
 		if len(species) > 0 {
 			if len(t.Species) > 0 && len(species) > 0 && !data.ElemInBothSlices(species, t.Species) {
@@ -114,19 +168,102 @@ func (r *Repository) QuerySpecies(ctx context.Context, species []string, display
 }
 
 func (r *Repository) DeleteTreatment(ctx context.Context, name string) error {
-	res, err := r.treatments.DeleteOne(ctx, bson.M{"name": name})
+	ctx, end := observe(ctx, "DeleteTreatment")
+	defer end()
+
+	_, err := r.withTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		res, err := r.treatments.DeleteOne(ctx, bson.M{"name": name})
+		if err != nil {
+			return nil, err
+		}
+
+		if res.DeletedCount == 0 {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", name))
+		}
+
+		// make sure no other treatment refers to the deleted one
+		if err := r.removeTreatmentReferences(ctx, []string{name}); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	})
+
+	return err
+}
+
+func (r *Repository) UpdateTreatment(ctx context.Context, upd *treatmentv1.UpdateTreatmentRequest) (*treatmentv1.Treatment, error) {
+	ctx, end := observe(ctx, "UpdateTreatment")
+	defer end()
+
+	result, err := r.withTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+		t, err := r.findTreatment(sc, upd.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err := mergeTreatmentUpdate(t, upd)
+		if err != nil {
+			return nil, err
+		}
+
+		// fields that are not updated have already been validated
+		paths := upd.GetUpdateMask().GetPaths()
+
+		model := merged.ToProto()
+		if err := validation.TreatmentFields(model, paths).Err(); err != nil {
+			return nil, err
+		}
+
+		if len(merged.Species) > 0 && (len(paths) == 0 || slices.Contains(paths, "species")) {
+			if err := r.validateSpeciesExist(sc, merged.Species); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := r.treatments.UpdateOne(sc, bson.M{"name": upd.Name}, bson.M{
+			"$set": bson.M{
+				"displayName":               merged.DisplayName,
+				"helpText":                  merged.HelpText,
+				"species":                   merged.Species,
+				"initialTimeRequirement":    merged.InitialTimeRequirement,
+				"additionalTimeRequirement": merged.AdditionalTimeRequirement,
+				"allowedEmployees":          merged.AllowedEmployees,
+				"preferredEmployees":        merged.PreferredEmployees,
+				"matchEventText":            merged.MatchEventText,
+				"allowSelfBooking":          merged.AllowSelfBooking,
+				"resources":                 merged.Resources,
+			},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update treatment: %w", err)
+		}
+
+		return model, nil
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if res.DeletedCount == 0 {
-		return connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", name))
+	return result.(*treatmentv1.Treatment), nil
+}
+
+func (r *Repository) findTreatments(ctx context.Context, filter bson.M) ([]*treatmentv1.Treatment, error) {
+	ts, err := r.findTreatmentModels(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*treatmentv1.Treatment, len(ts))
+	for idx, t := range ts {
+		result[idx] = t.ToProto()
 	}
 
-	return nil
+	return result, nil
 }
 
-func (r *Repository) UpdateTreatment(ctx context.Context, upd *treatmentv1.UpdateTreatmentRequest) (*treatmentv1.Treatment, error) {
+// mergeTreatmentUpdate applies the fields of upd selected by the update mask
+// to t.
+func mergeTreatmentUpdate(t Treatment, upd *treatmentv1.UpdateTreatmentRequest) (Treatment, error) {
 	paths := []string{
 		"display_name",
 		"help_text",
@@ -144,81 +281,50 @@ func (r *Repository) UpdateTreatment(ctx context.Context, upd *treatmentv1.Updat
 		paths = p
 	}
 
-	set := bson.M{}
-
 	for _, p := range paths {
 		switch p {
 		case "name":
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("treatment name cannot be updated"))
+			return t, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("treatment name cannot be updated"))
 
 		case "display_name":
-			set["displayName"] = upd.DisplayName
+			t.DisplayName = upd.DisplayName
 
 		case "help_text":
-			set["helpText"] = upd.HelpText
+			t.HelpText = upd.HelpText
 
 		case "species":
-			set["species"] = upd.Species
+			t.Species = upd.Species
 
 		case "initial_time_requirement":
-			set["initialTimeRequirement"] = upd.InitialTimeRequirement.AsDuration()
+			t.InitialTimeRequirement = upd.InitialTimeRequirement.AsDuration()
 
 		case "additional_time_requirement":
-			set["additionalTimeRequirement"] = upd.AdditionalTimeRequirement.AsDuration()
+			t.AdditionalTimeRequirement = upd.AdditionalTimeRequirement.AsDuration()
 
 		case "allowed_employees":
-			set["allowedEmployees"] = upd.AllowedEmployees
+			t.AllowedEmployees = upd.AllowedEmployees
 
 		case "preferred_employees":
-			set["preferredEmployees"] = upd.PreferredEmployees
+			t.PreferredEmployees = upd.PreferredEmployees
 
 		case "match_event_text":
-			set["matchEventText"] = upd.MatchEventText
+			t.MatchEventText = upd.MatchEventText
 
 		case "allow_self_booking":
-			set["allowSelfBooking"] = upd.AllowSelfBooking
+			t.AllowSelfBooking = upd.AllowSelfBooking
 
 		case "resources":
-			set["resources"] = upd.Resources
+			t.Resources = upd.Resources
 
 		default:
-			return nil, fmt.Errorf("invalid message field path %q", p)
-		}
-	}
-
-	result, err := r.withTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
-		res := r.treatments.FindOneAndUpdate(ctx, bson.M{"name": upd.Name}, bson.M{
-			"$set": set,
-		}, options.FindOneAndUpdate().SetReturnDocument(options.After))
-
-		if err := res.Err(); err != nil {
-			if errors.Is(err, mongo.ErrNoDocuments) {
-				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("treatment with name %q not found", upd.Name))
-			}
-
-			return nil, err
+			return t, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid message field path %q", p))
 		}
-
-		var m Treatment
-		if err := res.Decode(&m); err != nil {
-			return nil, fmt.Errorf("failed to decode treatment database model: %w", err)
-		}
-
-		model := m.ToProto()
-		if err := r.validateTreatmentEmployees(model); err != nil {
-			return nil, err
-		}
-
-		return model, nil
-	})
-	if err != nil {
-		return nil, err
 	}
 
-	return result.(*treatmentv1.Treatment), nil
+	return t, nil
 }
 
-func (r *Repository) findTreatments(ctx context.Context, filter bson.M) ([]*treatmentv1.Treatment, error) {
+func (r *Repository) findTreatmentModels(ctx context.Context, filter bson.M) ([]Treatment, error) {
 	res, err := r.treatments.Find(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform find operation: %w", err)
@@ -229,24 +335,7 @@ func (r *Repository) findTreatments(ctx context.Context, filter bson.M) ([]*trea
 		return nil, fmt.Errorf("failed to decode one or more treatment database models: %w", err)
 	}
 
-	result := make([]*treatmentv1.Treatment, len(ts))
-	for idx, t := range ts {
-		result[idx] = t.ToProto()
-	}
-
-	return result, nil
-}
-
-func (r *Repository) validateTreatmentEmployees(t *treatmentv1.Treatment) error {
-	lm := data.IndexSlice(t.AllowedEmployees, func(s string) string { return s })
-
-	for _, e := range t.PreferredEmployees {
-		if _, ok := lm[e]; !ok {
-			return fmt.Errorf("preferred_employee %q is missing in allowed_employees list", e)
-		}
-	}
-
-	return nil
+	return ts, nil
 }
 
 func (r *Repository) validateSpeciesExist(ctx context.Context, speciesToValidate []string) error {
@@ -266,11 +355,13 @@ func (r *Repository) validateSpeciesExist(ctx context.Context, speciesToValidate
 	}
 
 	lm := data.IndexSlice(species, func(s Species) string { return s.Name })
-	for _, s := range speciesToValidate {
+
+	v := new(validation.Violations)
+	for idx, s := range speciesToValidate {
 		if _, ok := lm[s]; !ok {
-			return fmt.Errorf("species %q not found", s)
+			v.Add(fmt.Sprintf("species[%d]", idx), "species %q not found", s)
 		}
 	}
 
-	return nil
+	return v.Err()
 }
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/metrics"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (svc *Service) CreateBreed(ctx context.Context, req *connect.Request[treatmentv1alpha.Breed]) (*connect.Response[treatmentv1alpha.Breed], error) {
+	res, err := svc.Repository.CreateBreed(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.matchRulesChanged(ctx)
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) GetBreed(ctx context.Context, req *connect.Request[treatmentv1alpha.GetBreedRequest]) (*connect.Response[treatmentv1alpha.Breed], error) {
+	res, err := svc.Repository.GetBreed(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) ListBreeds(ctx context.Context, req *connect.Request[treatmentv1alpha.ListBreedsRequest]) (*connect.Response[treatmentv1alpha.ListBreedsResponse], error) {
+	res, err := svc.Repository.ListBreeds(ctx, req.Msg.Species, req.Msg.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.ListBreedsResponse{
+		Breeds: res,
+	}), nil
+}
+
+func (svc *Service) UpdateBreed(ctx context.Context, req *connect.Request[treatmentv1alpha.UpdateBreedRequest]) (*connect.Response[treatmentv1alpha.Breed], error) {
+	res, err := svc.Repository.UpdateBreed(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.matchRulesChanged(ctx)
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) DeleteBreed(ctx context.Context, req *connect.Request[treatmentv1alpha.DeleteBreedRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := svc.Repository.DeleteBreed(ctx, req.Msg.Name); err != nil {
+		return nil, err
+	}
+
+	svc.matchRulesChanged(ctx)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (svc *Service) DetectBreeds(ctx context.Context, req *connect.Request[treatmentv1alpha.DetectBreedsRequest]) (*connect.Response[treatmentv1alpha.DetectBreedsResponse], error) {
+	res, err := svc.Repository.DetectBreeds(ctx, req.Msg, svc.matchOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ObserveDetection("breeds", len(res.Breeds))
+
+	return connect.NewResponse(res), nil
+}
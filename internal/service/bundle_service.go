@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (svc *Service) CreateBundle(ctx context.Context, req *connect.Request[treatmentv1alpha.Bundle]) (*connect.Response[treatmentv1alpha.Bundle], error) {
+	res, err := svc.Repository.CreateBundle(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) GetBundle(ctx context.Context, req *connect.Request[treatmentv1alpha.GetBundleRequest]) (*connect.Response[treatmentv1alpha.Bundle], error) {
+	res, err := svc.Repository.GetBundle(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) ListBundles(ctx context.Context, req *connect.Request[treatmentv1alpha.ListBundlesRequest]) (*connect.Response[treatmentv1alpha.ListBundlesResponse], error) {
+	res, err := svc.Repository.ListBundles(ctx, req.Msg.Treatment)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.ListBundlesResponse{
+		Bundles: res,
+	}), nil
+}
+
+func (svc *Service) UpdateBundle(ctx context.Context, req *connect.Request[treatmentv1alpha.UpdateBundleRequest]) (*connect.Response[treatmentv1alpha.Bundle], error) {
+	res, err := svc.Repository.UpdateBundle(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) DeleteBundle(ctx context.Context, req *connect.Request[treatmentv1alpha.DeleteBundleRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := svc.Repository.DeleteBundle(ctx, req.Msg.Name); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"golang.org/x/sync/singleflight"
+)
+
+// catalogLoadTimeout is the maximum time spent on loading the catalog. The
+// catalog is loaded independently of the requests waiting for it so a
+// cancelled request does not fail all others.
+const catalogLoadTimeout = 30 * time.Second
+
+// catalogCache caches the self-booking catalog for a configurable
+// amount of time.
+type catalogCache struct {
+	group singleflight.Group
+
+	l       sync.Mutex
+	catalog *treatmentv1alpha.SelfBookingCatalog
+	expires time.Time
+
+	// generation is incremented whenever the cache is invalidated so
+	// catalogs loaded before are not stored anymore.
+	generation uint64
+}
+
+// invalidate drops the cached catalog. It must be called after all
+// changes to species, treatments and prices.
+func (c *catalogCache) invalidate() {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	c.catalog = nil
+	c.generation++
+}
+
+func (svc *Service) GetSelfBookingCatalog(ctx context.Context, req *connect.Request[treatmentv1alpha.GetSelfBookingCatalogRequest]) (*connect.Response[treatmentv1alpha.SelfBookingCatalog], error) {
+	catalog, err := svc.selfBookingCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Species != "" {
+		filtered := &treatmentv1alpha.SelfBookingCatalog{}
+
+		for _, s := range catalog.Species {
+			if s.Name == req.Msg.Species {
+				filtered.Species = append(filtered.Species, s)
+			}
+		}
+
+		catalog = filtered
+	}
+
+	response := connect.NewResponse(catalog)
+
+	if ttl := svc.Config.CatalogCacheTTL; ttl > 0 {
+		response.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(ttl.Seconds())))
+	}
+
+	return response, nil
+}
+
+func (svc *Service) selfBookingCatalog(ctx context.Context) (*treatmentv1alpha.SelfBookingCatalog, error) {
+	cache := &svc.catalogCache
+
+	cache.l.Lock()
+	if cache.catalog != nil && time.Now().Before(cache.expires) {
+		defer cache.l.Unlock()
+
+		return cache.catalog, nil
+	}
+	generation := cache.generation
+	cache.l.Unlock()
+
+	// concurrent requests share a single load per generation
+	ch := cache.group.DoChan(strconv.FormatUint(generation, 10), func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), catalogLoadTimeout)
+		defer cancel()
+
+		catalog, err := svc.Repository.SelfBookingCatalog(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		cache.l.Lock()
+		defer cache.l.Unlock()
+
+		if cache.generation == generation {
+			cache.catalog = catalog
+			cache.expires = time.Now().Add(svc.Config.CatalogCacheTTL)
+		}
+
+		return catalog, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		return res.Val.(*treatmentv1alpha.SelfBookingCatalog), nil
+	}
+}
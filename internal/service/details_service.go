@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+)
+
+func (svc *Service) GetTreatmentDetails(ctx context.Context, req *connect.Request[treatmentv1alpha.GetTreatmentDetailsRequest]) (*connect.Response[treatmentv1alpha.TreatmentDetails], error) {
+	res, err := svc.Repository.GetTreatmentDetails(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) UpdateTreatmentDetails(ctx context.Context, req *connect.Request[treatmentv1alpha.UpdateTreatmentDetailsRequest]) (*connect.Response[treatmentv1alpha.TreatmentDetails], error) {
+	res, err := svc.Repository.UpdateTreatmentDetails(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) CheckPrerequisites(ctx context.Context, req *connect.Request[treatmentv1alpha.CheckPrerequisitesRequest]) (*connect.Response[treatmentv1alpha.CheckPrerequisitesResponse], error) {
+	res, err := svc.Repository.CheckPrerequisites(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) RenderInstructions(ctx context.Context, req *connect.Request[treatmentv1alpha.RenderInstructionsRequest]) (*connect.Response[treatmentv1alpha.RenderInstructionsResponse], error) {
+	res, err := svc.Repository.RenderInstructions(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) ListTreatmentsWithDetails(ctx context.Context, req *connect.Request[treatmentv1alpha.ListTreatmentsWithDetailsRequest]) (*connect.Response[treatmentv1alpha.ListTreatmentsWithDetailsResponse], error) {
+	res, err := svc.Repository.ListTreatmentsWithDetails(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.ListTreatmentsWithDetailsResponse{
+		Treatments: res,
+	}), nil
+}
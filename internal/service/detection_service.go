@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/metrics"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/repo"
+)
+
+func (svc *Service) DetectTreatments(ctx context.Context, req *connect.Request[treatmentv1alpha.DetectTreatmentsRequest]) (*connect.Response[treatmentv1alpha.DetectTreatmentsResponse], error) {
+	res, err := svc.Repository.DetectTreatments(ctx, req.Msg, svc.matchOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ObserveDetection("treatments", len(res.Matches))
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) AnalyzeMatchRules(ctx context.Context, req *connect.Request[treatmentv1alpha.AnalyzeMatchRulesRequest]) (*connect.Response[treatmentv1alpha.AnalyzeMatchRulesResponse], error) {
+	res, err := svc.Repository.AnalyzeMatchRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.AnalyzeMatchRulesResponse{
+		Issues: res,
+	}), nil
+}
+
+// matchRuleAnalysisTimeout is the maximum time spent on a background
+// analysis of the match rules.
+const matchRuleAnalysisTimeout = time.Minute
+
+// matchRuleWatcher analyzes the match rules in the background after changes
+// and logs issues that have not been reported before. Concurrent changes are
+// coalesced so at most one analysis is running at any time.
+type matchRuleWatcher struct {
+	l       sync.Mutex
+	running bool
+	pending bool
+
+	// known holds the keys of all issues found by the last analysis. It is
+	// nil until the first analysis completed.
+	known map[string]struct{}
+}
+
+// matchRulesChanged schedules a background analysis of the match rules if
+// warnings are enabled. ctx is only used for its values.
+func (svc *Service) matchRulesChanged(ctx context.Context) {
+	if !svc.Config.WarnMatchRuleConflicts {
+		return
+	}
+
+	w := &svc.matchRules
+
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	if w.running {
+		w.pending = true
+		return
+	}
+
+	w.running = true
+
+	go svc.watchMatchRules(context.WithoutCancel(ctx))
+}
+
+func (svc *Service) watchMatchRules(ctx context.Context) {
+	w := &svc.matchRules
+
+	for {
+		svc.analyzeMatchRules(ctx)
+
+		w.l.Lock()
+		if !w.pending {
+			w.running = false
+			w.l.Unlock()
+
+			return
+		}
+
+		w.pending = false
+		w.l.Unlock()
+	}
+}
+
+func (svc *Service) analyzeMatchRules(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, matchRuleAnalysisTimeout)
+	defer cancel()
+
+	issues, err := svc.Repository.AnalyzeMatchRules(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to analyze match rules", "error", err)
+		return
+	}
+
+	keys := make(map[string]struct{}, len(issues))
+	for _, i := range issues {
+		keys[repo.IssueKey(i)] = struct{}{}
+	}
+
+	// known is only accessed by the running watcher, see matchRulesChanged
+	known := svc.matchRules.known
+	svc.matchRules.known = keys
+
+	// the first analysis only records the existing issues
+	if known == nil {
+		return
+	}
+
+	for _, i := range issues {
+		if _, ok := known[repo.IssueKey(i)]; ok {
+			continue
+		}
+
+		slog.WarnContext(ctx, "new match rule issue", "kind", i.Kind.String(), "entity", i.Entity, "description", i.Description)
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/config"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// FixtureRegressionHeader is set on responses of update calls for each
+// detection fixture that fails due to the update. It's only used if
+// DETECTION_FIXTURE_MODE is set to "warn".
+const FixtureRegressionHeader = "Detection-Fixture-Regression"
+
+func (svc *Service) CreateDetectionFixture(ctx context.Context, req *connect.Request[treatmentv1alpha.DetectionFixture]) (*connect.Response[treatmentv1alpha.DetectionFixture], error) {
+	res, err := svc.Repository.CreateDetectionFixture(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) ListDetectionFixtures(ctx context.Context, req *connect.Request[treatmentv1alpha.ListDetectionFixturesRequest]) (*connect.Response[treatmentv1alpha.ListDetectionFixturesResponse], error) {
+	res, err := svc.Repository.ListDetectionFixtures(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.ListDetectionFixturesResponse{
+		Fixtures: res,
+	}), nil
+}
+
+func (svc *Service) DeleteDetectionFixture(ctx context.Context, req *connect.Request[treatmentv1alpha.DeleteDetectionFixtureRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := svc.Repository.DeleteDetectionFixture(ctx, req.Msg.Name); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (svc *Service) EvaluateDetectionFixtures(ctx context.Context, req *connect.Request[treatmentv1alpha.EvaluateDetectionFixturesRequest]) (*connect.Response[treatmentv1alpha.EvaluateDetectionFixturesResponse], error) {
+	res, err := svc.Repository.EvaluateDetectionFixtures(ctx, req.Msg.Names, svc.matchOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.EvaluateDetectionFixturesResponse{
+		Results: res,
+	}), nil
+}
+
+// guardFixtures executes fn while guarding detection fixtures according
+// to the configured DetectionFixtureMode. Regressions are only returned in
+// warn mode, otherwise the change is rejected.
+func guardFixtures[T any](ctx context.Context, svc *Service, fn func(context.Context) (T, error)) (T, []*treatmentv1alpha.DetectionFixtureResult, error) {
+	if svc.Config.DetectionFixtureMode == config.FixtureModeOff {
+		res, err := fn(ctx)
+		return res, nil, err
+	}
+
+	reject := svc.Config.DetectionFixtureMode == config.FixtureModeReject
+
+	res, regressions, err := svc.Repository.GuardDetectionFixtures(ctx, svc.matchOptions(), reject, func(ctx context.Context) (any, error) {
+		return fn(ctx)
+	})
+	if err != nil {
+		var zero T
+		return zero, nil, err
+	}
+
+	return res.(T), regressions, nil
+}
+
+func addFixtureRegressionHeaders[T any](response *connect.Response[T], regressions []*treatmentv1alpha.DetectionFixtureResult) {
+	for _, r := range regressions {
+		response.Header().Add(FixtureRegressionHeader, r.Name)
+	}
+}
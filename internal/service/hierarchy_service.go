@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+)
+
+func (svc *Service) SetSpeciesParent(ctx context.Context, req *connect.Request[treatmentv1alpha.SetSpeciesParentRequest]) (*connect.Response[treatmentv1alpha.SpeciesNode], error) {
+	res, err := svc.Repository.SetSpeciesParent(ctx, req.Msg.Name, req.Msg.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) GetSpeciesHierarchy(ctx context.Context, req *connect.Request[treatmentv1alpha.GetSpeciesHierarchyRequest]) (*connect.Response[treatmentv1alpha.GetSpeciesHierarchyResponse], error) {
+	res, err := svc.Repository.GetSpeciesHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.GetSpeciesHierarchyResponse{
+		Species: res,
+	}), nil
+}
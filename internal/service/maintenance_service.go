@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+	"github.com/tierklinik-dobersberg/apis/pkg/auth"
+	"github.com/tierklinik-dobersberg/apis/pkg/events"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (svc *Service) MergeSpecies(ctx context.Context, req *connect.Request[treatmentv1alpha.MergeSpeciesRequest]) (*connect.Response[treatmentv1alpha.MergeSpeciesResponse], error) {
+	if req.Msg.DryRun {
+		res, err := svc.Repository.MergeSpecies(ctx, req.Msg)
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(res), nil
+	}
+
+	res, regressions, err := guardFixtures(ctx, svc, func(ctx context.Context) (*treatmentv1alpha.MergeSpeciesResponse, error) {
+		return svc.Repository.MergeSpecies(ctx, req.Msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	response := connect.NewResponse(res)
+	addFixtureRegressionHeaders(response, regressions)
+
+	return response, nil
+}
+
+func (svc *Service) RenameSpecies(ctx context.Context, req *connect.Request[treatmentv1alpha.RenameSpeciesRequest]) (*connect.Response[treatmentv1.Species], error) {
+	expiresAt := time.Now().Add(svc.Config.RenameAliasTTL)
+
+	res, err := svc.Repository.RenameSpecies(ctx, req.Msg.Name, req.Msg.NewName, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	svc.publishEvent(ctx, &treatmentv1alpha.SpeciesRenamedEvent{
+		OldName:        req.Msg.Name,
+		NewName:        req.Msg.NewName,
+		AliasExpiresAt: timestamppb.New(expiresAt),
+	})
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) RenameTreatment(ctx context.Context, req *connect.Request[treatmentv1alpha.RenameTreatmentRequest]) (*connect.Response[treatmentv1.Treatment], error) {
+	expiresAt := time.Now().Add(svc.Config.RenameAliasTTL)
+
+	res, err := svc.Repository.RenameTreatment(ctx, req.Msg.Name, req.Msg.NewName, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	svc.publishEvent(ctx, &treatmentv1alpha.TreatmentRenamedEvent{
+		OldName:        req.Msg.Name,
+		NewName:        req.Msg.NewName,
+		AliasExpiresAt: timestamppb.New(expiresAt),
+	})
+
+	return connect.NewResponse(res), nil
+}
+
+// publishEvent publishes msg on the event service in the background. ctx is
+// only used for its values.
+func (svc *Service) publishEvent(ctx context.Context, msg proto.Message) {
+	if svc.Clients == nil || svc.Clients.EventService == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+		defer cancel()
+
+		if err := events.PublishTo(ctx, svc.Clients.EventService, msg, false); err != nil {
+			slog.ErrorContext(ctx, "failed to publish event", "type", string(msg.ProtoReflect().Descriptor().FullName()), "error", err)
+		}
+	}()
+}
+
+func (svc *Service) PreviewDeleteSpecies(ctx context.Context, req *connect.Request[treatmentv1alpha.PreviewDeleteSpeciesRequest]) (*connect.Response[treatmentv1alpha.DeleteSpeciesImpact], error) {
+	res, err := svc.Repository.PreviewDeleteSpecies(ctx, req.Msg.Name, req.Msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) DeleteSpeciesWithPolicy(ctx context.Context, req *connect.Request[treatmentv1alpha.DeleteSpeciesWithPolicyRequest]) (*connect.Response[treatmentv1alpha.DeleteSpeciesImpact], error) {
+	res, err := svc.Repository.DeleteSpecies(ctx, req.Msg.Name, req.Msg.Policy, remoteUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) ListSpeciesDeletions(ctx context.Context, req *connect.Request[treatmentv1alpha.ListSpeciesDeletionsRequest]) (*connect.Response[treatmentv1alpha.ListSpeciesDeletionsResponse], error) {
+	res, err := svc.Repository.ListSpeciesDeletions(ctx, req.Msg.Species)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.ListSpeciesDeletionsResponse{
+		Deletions: res,
+	}), nil
+}
+
+// remoteUserID returns the ID of the authenticated user or an empty string.
+func remoteUserID(ctx context.Context) string {
+	if u := auth.From(ctx); u != nil {
+		return u.ID
+	}
+
+	return ""
+}
+
+func (svc *Service) BatchMutateTreatments(ctx context.Context, req *connect.Request[treatmentv1alpha.BatchMutateTreatmentsRequest]) (*connect.Response[treatmentv1alpha.BatchMutateTreatmentsResponse], error) {
+	res, regressions, err := guardFixtures(ctx, svc, func(ctx context.Context) (*treatmentv1alpha.BatchMutateTreatmentsResponse, error) {
+		return svc.Repository.BatchMutateTreatments(ctx, req.Msg.Mutations)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	response := connect.NewResponse(res)
+	addFixtureRegressionHeaders(response, regressions)
+
+	return response, nil
+}
+
+func (svc *Service) ReplaceReferences(ctx context.Context, req *connect.Request[treatmentv1alpha.ReplaceReferencesRequest]) (*connect.Response[treatmentv1alpha.ReplaceReferencesResponse], error) {
+	res, err := svc.Repository.ReplaceReferences(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) CloneTreatment(ctx context.Context, req *connect.Request[treatmentv1alpha.CloneTreatmentRequest]) (*connect.Response[treatmentv1alpha.TreatmentWithDetails], error) {
+	res, err := svc.Repository.CloneTreatment(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (svc *Service) CreatePrice(ctx context.Context, req *connect.Request[treatmentv1alpha.Price]) (*connect.Response[treatmentv1alpha.Price], error) {
+	res, err := svc.Repository.CreatePrice(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+
+	return connect.NewResponse(res), nil
+}
+
+func (svc *Service) ListPrices(ctx context.Context, req *connect.Request[treatmentv1alpha.ListPricesRequest]) (*connect.Response[treatmentv1alpha.ListPricesResponse], error) {
+	res, err := svc.Repository.ListPrices(ctx, req.Msg.Treatment, req.Msg.Species)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&treatmentv1alpha.ListPricesResponse{
+		Prices: res,
+	}), nil
+}
+
+func (svc *Service) DeletePrice(ctx context.Context, req *connect.Request[treatmentv1alpha.DeletePriceRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := svc.Repository.DeletePrice(ctx, req.Msg.Id); err != nil {
+		return nil, err
+	}
+
+	svc.catalogCache.invalidate()
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (svc *Service) GetEffectivePrice(ctx context.Context, req *connect.Request[treatmentv1alpha.GetEffectivePriceRequest]) (*connect.Response[treatmentv1alpha.Price], error) {
+	at := time.Now()
+	if req.Msg.Time != nil {
+		at = req.Msg.Time.AsTime()
+	}
+
+	res, err := svc.Repository.GetEffectivePrice(ctx, req.Msg.Treatment, req.Msg.Species, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
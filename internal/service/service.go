@@ -6,7 +6,11 @@ import (
 	"github.com/bufbuild/connect-go"
 	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
 	"github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1/treatmentv1connect"
+	treatmentv1alpha "github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha"
+	"github.com/tierklinik-dobersberg/treatment-service/gen/go/tkd/treatment/v1alpha/treatmentv1alphaconnect"
 	"github.com/tierklinik-dobersberg/treatment-service/internal/config"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/metrics"
+	"github.com/tierklinik-dobersberg/treatment-service/internal/textmatch"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -15,12 +19,29 @@ type Service struct {
 
 	treatmentv1connect.UnimplementedSpeciesServiceHandler
 	treatmentv1connect.UnimplementedTreatmentServiceHandler
+	treatmentv1alphaconnect.UnimplementedDetectionServiceHandler
+	treatmentv1alphaconnect.UnimplementedSelfBookingCatalogServiceHandler
+	treatmentv1alphaconnect.UnimplementedTreatmentDetailsServiceHandler
+	treatmentv1alphaconnect.UnimplementedBundleServiceHandler
+	treatmentv1alphaconnect.UnimplementedPricingServiceHandler
+	treatmentv1alphaconnect.UnimplementedBreedServiceHandler
+	treatmentv1alphaconnect.UnimplementedSpeciesHierarchyServiceHandler
+	treatmentv1alphaconnect.UnimplementedSpeciesMaintenanceServiceHandler
+	treatmentv1alphaconnect.UnimplementedTreatmentMaintenanceServiceHandler
+
+	catalogCache catalogCache
+	matchRules   matchRuleWatcher
 }
 
 func New(p *config.Providers) *Service {
-	return &Service{
+	svc := &Service{
 		Providers: p,
 	}
+
+	// record the existing match rule issues so only new ones are reported
+	svc.matchRulesChanged(context.Background())
+
+	return svc
 }
 
 func (svc *Service) CreateSpecies(ctx context.Context, req *connect.Request[treatmentv1.Species]) (*connect.Response[treatmentv1.Species], error) {
@@ -29,14 +50,22 @@ func (svc *Service) CreateSpecies(ctx context.Context, req *connect.Request[trea
 		return nil, err
 	}
 
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
 	return connect.NewResponse(res), nil
 }
 
 func (svc *Service) DeleteSpecies(ctx context.Context, req *connect.Request[treatmentv1.DeleteSpeciesRequest]) (*connect.Response[emptypb.Empty], error) {
-	if err := svc.Repository.DeleteSpecies(ctx, req.Msg.Name); err != nil {
+	// DeleteSpecies keeps its original cascading behaviour, use
+	// DeleteSpeciesWithPolicy for other policies.
+	if _, err := svc.Repository.DeleteSpecies(ctx, req.Msg.Name, treatmentv1alpha.DeletePolicy_DELETE_POLICY_CASCADE, remoteUserID(ctx)); err != nil {
 		return nil, err
 	}
 
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
@@ -54,23 +83,40 @@ func (svc *Service) ListSpecies(ctx context.Context, req *connect.Request[treatm
 }
 
 func (svc *Service) UpdateSpecies(ctx context.Context, req *connect.Request[treatmentv1.UpdateSpeciesRequest]) (*connect.Response[treatmentv1.Species], error) {
-	res, err := svc.Repository.UpdateSpecies(ctx, req.Msg)
+	res, regressions, err := guardFixtures(ctx, svc, func(ctx context.Context) (*treatmentv1.Species, error) {
+		return svc.Repository.UpdateSpecies(ctx, req.Msg)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return connect.NewResponse(res), nil
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	response := connect.NewResponse(res)
+	addFixtureRegressionHeaders(response, regressions)
+
+	return response, nil
 }
 
 func (svc *Service) DetectSpecies(ctx context.Context, req *connect.Request[treatmentv1.DetectSpeciesRequest]) (*connect.Response[treatmentv1.ListSpeciesResponse], error) {
-	res, err := svc.Repository.DetectSpecies(ctx, req.Msg)
+	res, err := svc.Repository.DetectSpecies(ctx, req.Msg, svc.matchOptions())
 	if err != nil {
 		return nil, err
 	}
 
+	metrics.ObserveDetection("species", len(res))
+
 	response := &treatmentv1.ListSpeciesResponse{
 		Species: res,
 	}
 
 	return connect.NewResponse(response), nil
 }
+
+func (svc *Service) matchOptions() textmatch.Options {
+	return textmatch.Options{
+		Fuzzy:       svc.Config.FuzzySpeciesDetection,
+		MaxDistance: svc.Config.FuzzyMaxDistance,
+	}
+}
@@ -14,6 +14,9 @@ func (svc *Service) CreateTreatment(ctx context.Context, req *connect.Request[tr
 		return nil, err
 	}
 
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
 	return connect.NewResponse(res), nil
 }
 
@@ -34,12 +37,20 @@ func (svc *Service) ListTreatments(ctx context.Context, req *connect.Request[tre
 }
 
 func (svc *Service) UpdateTreatment(ctx context.Context, req *connect.Request[treatmentv1.UpdateTreatmentRequest]) (*connect.Response[treatmentv1.Treatment], error) {
-	res, err := svc.Repository.UpdateTreatment(ctx, req.Msg)
+	res, regressions, err := guardFixtures(ctx, svc, func(ctx context.Context) (*treatmentv1.Treatment, error) {
+		return svc.Repository.UpdateTreatment(ctx, req.Msg)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return connect.NewResponse(res), nil
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
+	response := connect.NewResponse(res)
+	addFixtureRegressionHeaders(response, regressions)
+
+	return response, nil
 }
 
 func (svc *Service) DeleteTreatment(ctx context.Context, req *connect.Request[treatmentv1.DeleteTreatmentRequest]) (*connect.Response[emptypb.Empty], error) {
@@ -47,6 +58,9 @@ func (svc *Service) DeleteTreatment(ctx context.Context, req *connect.Request[tr
 		return nil, err
 	}
 
+	svc.catalogCache.invalidate()
+	svc.matchRulesChanged(ctx)
+
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
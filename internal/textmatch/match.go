@@ -0,0 +1,117 @@
+package textmatch
+
+import (
+	"strings"
+)
+
+// Options configures how match words are compared against a text.
+type Options struct {
+	// Fuzzy enables typo-tolerant matching using german normalization and
+	// the edit distance between words. If false, only exact (case-insensitive)
+	// sub-string matches are reported.
+	Fuzzy bool
+
+	// MaxDistance is the upper bound for the edit distance of a fuzzy match.
+	// The distance actually allowed also depends on the length of the match
+	// word, see Options.Tolerance.
+	MaxDistance int
+}
+
+// Tolerance returns the edit distance that is tolerated for the (normalized)
+// word. One edit is allowed per four runes, capped at MaxDistance, so short
+// words must always match exactly.
+func (o Options) Tolerance(word string) int {
+	return min(o.MaxDistance, len([]rune(word))/4)
+}
+
+// Match describes how a single match word matched a text.
+type Match struct {
+	Word     string
+	Exact    bool
+	Distance int
+}
+
+// Text is a pre-processed input text that can be matched against
+// many match words.
+type Text struct {
+	lower string
+	stems []string
+}
+
+func NewText(s string) Text {
+	return Text{
+		lower: strings.ToLower(s),
+		stems: StemAll(s),
+	}
+}
+
+// Match checks if word is contained in the text. Exact sub-string matches
+// are always preferred over fuzzy ones.
+func (t Text) Match(word string, opts Options) (Match, bool) {
+	if word == "" {
+		return Match{}, false
+	}
+
+	if strings.Contains(t.lower, strings.ToLower(word)) {
+		return Match{Word: word, Exact: true}, true
+	}
+
+	if !opts.Fuzzy {
+		return Match{}, false
+	}
+
+	wordStems := StemAll(word)
+	if len(wordStems) == 0 || len(wordStems) > len(t.stems) {
+		return Match{}, false
+	}
+
+	needle := strings.Join(wordStems, " ")
+	tolerance := opts.Tolerance(needle)
+
+	best := -1
+	for i := 0; i+len(wordStems) <= len(t.stems); i++ {
+		d := Distance(strings.Join(t.stems[i:i+len(wordStems)], " "), needle)
+
+		if best == -1 || d < best {
+			best = d
+		}
+	}
+
+	if best < 0 || best > tolerance {
+		return Match{}, false
+	}
+
+	return Match{Word: word, Distance: best}, true
+}
+
+// Score accumulates the matches of an entity (like a species) so entities
+// can be ranked against each other.
+type Score struct {
+	Exact    int
+	Fuzzy    int
+	Distance int
+}
+
+func (s *Score) Add(m Match) {
+	if m.Exact {
+		s.Exact++
+	} else {
+		s.Fuzzy++
+		s.Distance += m.Distance
+	}
+}
+
+// Compare returns a positive number if s ranks higher than o, a negative one
+// if it ranks lower and zero if both are equal. Any exact match outranks
+// an arbitrary number of fuzzy matches.
+func (s Score) Compare(o Score) int {
+	if s.Exact != o.Exact {
+		return s.Exact - o.Exact
+	}
+
+	if s.Fuzzy != o.Fuzzy {
+		return s.Fuzzy - o.Fuzzy
+	}
+
+	return o.Distance - s.Distance
+}
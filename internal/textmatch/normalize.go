@@ -0,0 +1,74 @@
+package textmatch
+
+import (
+	"strings"
+	"unicode"
+)
+
+var foldReplacer = strings.NewReplacer(
+	"ä", "ae",
+	"ö", "oe",
+	"ü", "ue",
+	"ß", "ss",
+)
+
+// Normalize lower-cases s and folds german umlauts and the sharp s so
+// "Kätzchen" and "Kaetzchen" end up with the same representation.
+func Normalize(s string) string {
+	return foldReplacer.Replace(strings.ToLower(s))
+}
+
+// Tokenize normalizes s and splits it into words. Everything that is
+// neither a letter nor a digit is treated as a separator.
+func Tokenize(s string) []string {
+	return strings.FieldsFunc(Normalize(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// suffixes that are stripped by Stem, ordered so longer suffixes are tried
+// first. This covers the most common german plural and diminutive endings
+// and is by no means a complete stemmer.
+var suffixes = []string{
+	"chens",
+	"chen",
+	"lein",
+	"ern",
+	"er",
+	"en",
+	"e",
+	"n",
+	"s",
+}
+
+// minStemLength is the minimum number of runes that must be left after
+// removing a suffix. This prevents short words like "hase" or "rind" from
+// being stemmed away.
+const minStemLength = 4
+
+// Stem removes common german plural and diminutive suffixes from an already
+// normalized word.
+func Stem(word string) string {
+	for _, s := range suffixes {
+		if !strings.HasSuffix(word, s) {
+			continue
+		}
+
+		stem := strings.TrimSuffix(word, s)
+		if len([]rune(stem)) >= minStemLength {
+			return stem
+		}
+	}
+
+	return word
+}
+
+// StemAll tokenizes s and stems each token.
+func StemAll(s string) []string {
+	tokens := Tokenize(s)
+	for idx, t := range tokens {
+		tokens[idx] = Stem(t)
+	}
+
+	return tokens
+}
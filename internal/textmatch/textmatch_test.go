@@ -0,0 +1,133 @@
+package textmatch
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"hund", "hund", 0},
+		{"kitten", "sitting", 3},
+		{"kanins", "kanin", 1},
+		{"meerschwei", "meerschwein", 1},
+		// runes, not bytes, are counted
+		{"ä", "a", 1},
+	}
+
+	for _, c := range cases {
+		if got := Distance(c.a, c.b); got != c.expected {
+			t.Errorf("Distance(%q, %q): expected %d but got %d", c.a, c.b, c.expected, got)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"Kätzchen": "kaetzchen",
+		"Straße":   "strasse",
+		"MÖWE":     "moewe",
+		"Hund":     "hund",
+	}
+
+	for in, expected := range cases {
+		if got := Normalize(in); got != expected {
+			t.Errorf("Normalize(%q): expected %q but got %q", in, expected, got)
+		}
+	}
+}
+
+func TestStem(t *testing.T) {
+	cases := map[string]string{
+		"kaninchen":       "kanin",
+		"kaninschen":      "kanins",
+		"meerschweinchen": "meerschwein",
+		"meerschwein":     "meerschwei",
+		"katzen":          "katz",
+		"hunde":           "hund",
+		// short words are not stemmed away
+		"hase": "hase",
+		"rind": "rind",
+	}
+
+	for in, expected := range cases {
+		if got := Stem(in); got != expected {
+			t.Errorf("Stem(%q): expected %q but got %q", in, expected, got)
+		}
+	}
+}
+
+func TestStemAll(t *testing.T) {
+	got := StemAll("Zwei Kätzchen, ein Meerschweinchen!")
+	expected := []string{"zwei", "kaetz", "ein", "meerschwein"}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v but got %v", expected, got)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	fuzzy := Options{Fuzzy: true, MaxDistance: 2}
+
+	cases := []struct {
+		name     string
+		text     string
+		word     string
+		opts     Options
+		matches  bool
+		exact    bool
+		distance int
+	}{
+		{"exact sub-string", "Mein Kaninchen hustet", "kaninchen", Options{}, true, true, 0},
+		{"exact in fuzzy mode", "Mein Kaninchen hustet", "Kaninchen", fuzzy, true, true, 0},
+		{"typo without fuzzy mode", "Kaninschen", "Kaninchen", Options{}, false, false, 0},
+		{"typo", "Kaninschen", "Kaninchen", fuzzy, true, false, 1},
+		{"plural stripping", "Meerschwein", "Meerschweinchen", fuzzy, true, false, 1},
+		{"umlaut folding", "Kaetzchen", "Kätzchen", fuzzy, true, false, 0},
+		{"short words must match exactly", "Kur", "Kuh", fuzzy, false, false, 0},
+		{"tolerance is capped", "Meerschwein", "Meerschweinchen", Options{Fuzzy: true, MaxDistance: 0}, false, false, 0},
+		{"unrelated", "Hund", "Katze", fuzzy, false, false, 0},
+		{"empty word", "Hund", "", fuzzy, false, false, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, ok := NewText(c.text).Match(c.word, c.opts)
+			if ok != c.matches {
+				t.Fatalf("expected match=%t but got %t", c.matches, ok)
+			}
+
+			if !ok {
+				return
+			}
+
+			if m.Exact != c.exact || m.Distance != c.distance {
+				t.Errorf("expected exact=%t distance=%d but got exact=%t distance=%d", c.exact, c.distance, m.Exact, m.Distance)
+			}
+		})
+	}
+}
+
+func TestScoreCompare(t *testing.T) {
+	exact := Score{Exact: 1}
+	fuzzy := Score{Fuzzy: 5}
+
+	if exact.Compare(fuzzy) <= 0 {
+		t.Errorf("expected an exact match to outrank any number of fuzzy matches")
+	}
+
+	if fuzzy.Compare(exact) >= 0 {
+		t.Errorf("expected fuzzy matches to rank lower than an exact match")
+	}
+
+	close, far := Score{Fuzzy: 1, Distance: 1}, Score{Fuzzy: 1, Distance: 2}
+	if close.Compare(far) <= 0 {
+		t.Errorf("expected a smaller distance to rank higher")
+	}
+}
@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewInterceptor returns a connect interceptor that starts a server span for
+// each RPC. The trace context of the caller is extracted from the request
+// headers.
+func NewInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(ar.Header()))
+
+			procedure := ar.Spec().Procedure
+			service, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+
+			ctx, span := Tracer().Start(ctx, strings.TrimPrefix(procedure, "/"),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("connect_rpc"),
+					semconv.RPCService(service),
+					semconv.RPCMethod(method),
+				),
+			)
+			defer span.End()
+
+			res, err := next(ctx, ar)
+			if err != nil {
+				code := connect.CodeOf(err)
+
+				span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", code.String()))
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return res, err
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewCommandMonitor returns a MongoDB command monitor that creates a client
+// span for each database command. Command documents are not recorded as
+// they may contain personal data.
+func NewCommandMonitor() *event.CommandMonitor {
+	var spans sync.Map
+
+	end := func(requestID int64, failure string) {
+		s, ok := spans.LoadAndDelete(requestID)
+		if !ok {
+			return
+		}
+
+		span := s.(trace.Span)
+		if failure != "" {
+			span.SetStatus(codes.Error, failure)
+		}
+
+		span.End()
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			attrs := []attribute.KeyValue{
+				semconv.DBSystemMongoDB,
+				semconv.DBNamespace(evt.DatabaseName),
+				semconv.DBOperationName(evt.CommandName),
+			}
+
+			if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+				attrs = append(attrs, semconv.DBCollectionName(collection))
+			}
+
+			_, span := Tracer().Start(ctx, "mongodb."+evt.CommandName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+
+			spans.Store(evt.RequestID, span)
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			end(evt.RequestID, "")
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			end(evt.RequestID, evt.Failure)
+		},
+	}
+}
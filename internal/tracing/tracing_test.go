@@ -0,0 +1,223 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// record installs a global tracer provider that records all spans.
+func record(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return recorder
+}
+
+func attributeValue(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+
+	return attribute.Value{}, false
+}
+
+// unaryRequest returns a request for a fake unary procedure. connect only sets
+// the spec of requests sent through a client, so the request is captured by
+// an interceptor of a client that is never connected to a server.
+func unaryRequest(t *testing.T, header http.Header) connect.AnyRequest {
+	t.Helper()
+
+	var captured connect.AnyRequest
+
+	capture := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+			captured = ar
+			return nil, errors.New("captured")
+		}
+	})
+
+	client := connect.NewClient[emptypb.Empty, emptypb.Empty](http.DefaultClient, "http://localhost/tkd.test.v1.TestService/Echo", connect.WithInterceptors(capture))
+
+	req := connect.NewRequest(&emptypb.Empty{})
+	for k, v := range header {
+		req.Header()[k] = v
+	}
+
+	_, _ = client.CallUnary(context.Background(), req)
+
+	if captured == nil {
+		t.Fatalf("failed to capture request")
+	}
+
+	return captured
+}
+
+func TestInterceptor(t *testing.T) {
+	recorder := record(t)
+
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	header := http.Header{}
+	otel.GetTextMapPropagator().Inject(trace.ContextWithSpanContext(context.Background(), parent), propagation.HeaderCarrier(header))
+
+	cases := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"success", nil, ""},
+		{"failure", connect.NewError(connect.CodeNotFound, errors.New("not found")), "not_found"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var handlerSpan trace.SpanContext
+
+			next := connect.UnaryFunc(func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+				handlerSpan = trace.SpanContextFromContext(ctx)
+
+				if c.err != nil {
+					return nil, c.err
+				}
+
+				return connect.NewResponse(&emptypb.Empty{}), nil
+			})
+
+			if _, err := NewInterceptor()(next)(context.Background(), unaryRequest(t, header)); !errors.Is(err, c.err) {
+				t.Fatalf("expected error %v but got %v", c.err, err)
+			}
+
+			spans := recorder.Ended()
+			span := spans[len(spans)-1]
+
+			if span.Name() != "tkd.test.v1.TestService/Echo" {
+				t.Errorf("unexpected span name %q", span.Name())
+			}
+
+			if span.SpanKind() != trace.SpanKindServer {
+				t.Errorf("expected a server span but got %s", span.SpanKind())
+			}
+
+			if span.Parent().SpanID() != parent.SpanID() || span.SpanContext().TraceID() != parent.TraceID() {
+				t.Errorf("expected the span to continue the trace of the caller")
+			}
+
+			if handlerSpan.SpanID() != span.SpanContext().SpanID() {
+				t.Errorf("expected the handler to be called with the server span")
+			}
+
+			if v, _ := attributeValue(span, "rpc.method"); v.AsString() != "Echo" {
+				t.Errorf("unexpected rpc.method %q", v.AsString())
+			}
+
+			code, _ := attributeValue(span, "rpc.connect_rpc.error_code")
+			if code.AsString() != c.code {
+				t.Errorf("expected error code %q but got %q", c.code, code.AsString())
+			}
+
+			expected := codes.Unset
+			if c.err != nil {
+				expected = codes.Error
+			}
+
+			if span.Status().Code != expected {
+				t.Errorf("expected status %s but got %s", expected, span.Status().Code)
+			}
+		})
+	}
+}
+
+func TestCommandMonitor(t *testing.T) {
+	recorder := record(t)
+	monitor := NewCommandMonitor()
+
+	ctx, parent := Tracer().Start(context.Background(), "parent")
+	defer parent.End()
+
+	command, err := bson.Marshal(bson.D{
+		{Key: "find", Value: "species"},
+		{Key: "filter", Value: bson.D{{Key: "name", Value: "cat"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor.Started(ctx, &event.CommandStartedEvent{Command: command, DatabaseName: "treatments", CommandName: "find", RequestID: 1})
+	monitor.Started(ctx, &event.CommandStartedEvent{Command: command, DatabaseName: "treatments", CommandName: "find", RequestID: 2})
+
+	if n := len(recorder.Ended()); n != 0 {
+		t.Fatalf("expected spans to be ended once the command finished, got %d ended spans", n)
+	}
+
+	monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 1}})
+	monitor.Failed(ctx, &event.CommandFailedEvent{CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 2}, Failure: "boom"})
+
+	// unknown request IDs are ignored
+	monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 3}})
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans but got %d", len(spans))
+	}
+
+	for idx, span := range spans {
+		if span.Name() != "mongodb.find" || span.SpanKind() != trace.SpanKindClient {
+			t.Errorf("unexpected span %q of kind %s", span.Name(), span.SpanKind())
+		}
+
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("expected the command span to be a child of the operation span")
+		}
+
+		if v, _ := attributeValue(span, "db.collection.name"); v.AsString() != "species" {
+			t.Errorf("unexpected collection %q", v.AsString())
+		}
+
+		// the command document might contain personal data
+		for _, kv := range span.Attributes() {
+			if kv.Value.Type() == attribute.STRING && kv.Value.AsString() == "cat" {
+				t.Errorf("command document must not be recorded, found in %s", kv.Key)
+			}
+		}
+
+		expected := codes.Unset
+		if idx == 1 {
+			expected = codes.Error
+		}
+
+		if span.Status().Code != expected {
+			t.Errorf("expected status %s but got %s", expected, span.Status().Code)
+		}
+	}
+}
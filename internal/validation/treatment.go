@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	treatmentv1 "github.com/tierklinik-dobersberg/apis/gen/go/tkd/treatment/v1"
+)
+
+// Species validates s except for its name, which cannot be changed after
+// creation. Use Violations.Name when creating a species.
+func Species(s *treatmentv1.Species) *Violations {
+	v := new(Violations)
+
+	v.Unique("match_words", s.MatchWords)
+
+	return v
+}
+
+// Treatment validates t except for its name, which cannot be changed after
+// creation. Use Violations.Name when creating a treatment.
+func Treatment(t *treatmentv1.Treatment) *Violations {
+	return TreatmentFields(t, nil)
+}
+
+// TreatmentFields works like Treatment but only validates the fields
+// selected by paths, as used in update masks. If paths is empty, all fields
+// are validated.
+func TreatmentFields(t *treatmentv1.Treatment, paths []string) *Violations {
+	v := new(Violations)
+
+	selected := func(field string) bool {
+		return len(paths) == 0 || slices.Contains(paths, field)
+	}
+
+	if selected("initial_time_requirement") {
+		v.Duration("initial_time_requirement", t.InitialTimeRequirement.AsDuration())
+	}
+	if selected("additional_time_requirement") {
+		v.Duration("additional_time_requirement", t.AdditionalTimeRequirement.AsDuration())
+	}
+
+	lists := []struct {
+		field string
+		list  []string
+	}{
+		{"species", t.Species},
+		{"allowed_employees", t.AllowedEmployees},
+		{"preferred_employees", t.PreferredEmployees},
+		{"match_event_text", t.MatchEventText},
+		{"resources", t.Resources},
+	}
+
+	for _, l := range lists {
+		if selected(l.field) {
+			v.Unique(l.field, l.list)
+		}
+	}
+
+	// preferred employees must be allowed to handle the treatment
+	if selected("allowed_employees") || selected("preferred_employees") {
+		for idx, e := range t.PreferredEmployees {
+			if !slices.Contains(t.AllowedEmployees, e) {
+				v.Add(fmt.Sprintf("preferred_employees[%d]", idx), "preferred employee %q is missing in allowed_employees list", e)
+			}
+		}
+	}
+
+	return v
+}
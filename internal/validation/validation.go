@@ -0,0 +1,97 @@
+// Package validation implements the domain validation rules for species and
+// treatments. Violations are reported as google.rpc.BadRequest field
+// violations using CodeInvalidArgument.
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// MaxDuration is the upper bound for treatment time requirements.
+const MaxDuration = 24 * time.Hour
+
+var nameRe = regexp.MustCompile(`^[a-z0-9]+(?:[-_][a-z0-9]+)*$`)
+
+// Violations collects field violations.
+type Violations struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Add adds a new violation for field.
+func (v *Violations) Add(field, format string, args ...any) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Len returns the number of violations.
+func (v *Violations) Len() int {
+	return len(v.violations)
+}
+
+// Name ensures name is a valid slug consisting of lower-case letters, digits,
+// dashes and underscores.
+func (v *Violations) Name(field, name string) {
+	if !nameRe.MatchString(name) {
+		v.Add(field, "%q is not a valid name, only lower-case letters, digits, dashes and underscores are allowed", name)
+	}
+}
+
+// Duration ensures d is within [0, MaxDuration].
+func (v *Violations) Duration(field string, d time.Duration) {
+	switch {
+	case d < 0:
+		v.Add(field, "must not be negative")
+	case d > MaxDuration:
+		v.Add(field, "must not exceed %s", MaxDuration)
+	}
+}
+
+// Unique ensures list does not contain empty or duplicate values.
+func (v *Violations) Unique(field string, list []string) {
+	seen := make(map[string]struct{}, len(list))
+
+	for idx, value := range list {
+		if strings.TrimSpace(value) == "" {
+			v.Add(fmt.Sprintf("%s[%d]", field, idx), "must not be empty")
+			continue
+		}
+
+		if _, ok := seen[value]; ok {
+			v.Add(fmt.Sprintf("%s[%d]", field, idx), "duplicate value %q", value)
+			continue
+		}
+
+		seen[value] = struct{}{}
+	}
+}
+
+// Err returns nil if there are no violations and a connect error with
+// CodeInvalidArgument and a BadRequest detail otherwise.
+func (v *Violations) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(v.violations))
+	for idx, fv := range v.violations {
+		descriptions[idx] = fv.Field + ": " + fv.Description
+	}
+
+	cerr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("validation failed: %s", strings.Join(descriptions, "; ")))
+
+	if detail, err := connect.NewErrorDetail(&errdetails.BadRequest{
+		FieldViolations: v.violations,
+	}); err == nil {
+		cerr.AddDetail(detail)
+	}
+
+	return cerr
+}
@@ -0,0 +1,22 @@
+#!/bin/sh
+#
+# Generates the Go code for the API definitions in ./proto.
+#
+# The definitions in this repository are not (yet) part of
+# github.com/tierklinik-dobersberg/apis but import tkd/common and
+# tkd/treatment from there, so the apis proto module is copied next to
+# our own definitions before running buf.
+set -e
+
+ROOT=$(cd "$(dirname "$0")/.." && pwd)
+APIS_DIR=$(cd "$ROOT" && go list -m -f '{{.Dir}}' github.com/tierklinik-dobersberg/apis)
+
+WORK_DIR=$(mktemp -d)
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+cp -r "$APIS_DIR/proto/." "$WORK_DIR/"
+chmod -R u+w "$WORK_DIR"
+cp -r "$ROOT/proto/tkd/." "$WORK_DIR/tkd/"
+
+cd "$WORK_DIR"
+buf generate . --template "$ROOT/buf.gen.yaml" --path tkd/treatment/v1alpha -o "$ROOT"
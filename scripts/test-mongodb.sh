@@ -0,0 +1,49 @@
+#!/bin/sh
+#
+# Runs the repository tests against a standalone mongod and a single node
+# replica set. The standalone deployment covers the serialized write
+# fallback including rollbacks while the replica set covers transactions.
+#
+# Requires docker. The MongoDB image can be changed using MONGODB_IMAGE.
+set -e
+
+ROOT=$(cd "$(dirname "$0")/.." && pwd)
+IMAGE=${MONGODB_IMAGE:-mongo:7}
+
+STANDALONE=treatment-service-test-standalone
+REPLSET=treatment-service-test-replset
+
+cleanup() {
+    docker rm -f "$STANDALONE" "$REPLSET" >/dev/null 2>&1 || true
+}
+trap cleanup EXIT
+
+wait_for() {
+    for _ in $(seq 1 60); do
+        if docker exec "$1" mongosh --quiet --eval "$2" >/dev/null 2>&1; then
+            return 0
+        fi
+        sleep 1
+    done
+
+    echo "$1 did not become ready" >&2
+    exit 1
+}
+
+docker run -d --name "$STANDALONE" -p 27017:27017 "$IMAGE" >/dev/null
+docker run -d --name "$REPLSET" -p 27018:27017 "$IMAGE" --replSet rs0 >/dev/null
+
+wait_for "$STANDALONE" "db.runCommand({ ping: 1 })"
+wait_for "$REPLSET" "db.runCommand({ ping: 1 })"
+
+docker exec "$REPLSET" mongosh --quiet --eval \
+    'rs.initiate({ _id: "rs0", members: [{ _id: 0, host: "localhost:27017" }] })' >/dev/null
+wait_for "$REPLSET" "if (!db.hello().isWritablePrimary) { quit(1) }"
+
+cd "$ROOT"
+
+echo "running tests against standalone mongod"
+TEST_MONGODB_URL="mongodb://localhost:27017" go test -count=1 ./internal/repo/...
+
+echo "running tests against replica set"
+TEST_MONGODB_URL="mongodb://localhost:27018/?directConnection=true" go test -count=1 ./internal/repo/...